@@ -35,16 +35,21 @@ var command = &cobra.Command{
 			panic(err)
 		}
 
-		loc, err := time.LoadLocation("Asia/Jakarta")
+		err = dedupFieldSchedules(db)
 		if err != nil {
 			panic(err)
 		}
-		time.Local = loc
 
 		err = db.AutoMigrate(
+			&models.Venue{},
 			&models.Field{},
 			&models.FieldSchedule{},
 			&models.Time{},
+			&models.ScheduleTemplate{},
+			&models.PricingRule{},
+			&models.Closure{},
+			&models.FieldScheduleHistory{},
+			&models.AuditLog{},
 		)
 		if err != nil {
 			panic(err)
@@ -58,6 +63,7 @@ var command = &cobra.Command{
 
 		router := gin.Default()
 		router.Use(middlewares.HandlePanic())
+		router.Use(middlewares.RequestID())
 		router.NoRoute(func(c *gin.Context) {
 			c.JSON(http.StatusNotFound, response.Response{
 				Status:  constants.Error,
@@ -73,7 +79,7 @@ var command = &cobra.Command{
 		router.Use(func(c *gin.Context) {
 			c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 			c.Writer.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, PATCH")
-			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-api-key")
+			c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, x-service-name, x-request-at, x-api-key, x-request-id")
 			if c.Request.Method == "OPTIONS" {
 				c.AbortWithStatus(204)
 				return
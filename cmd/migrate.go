@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"field-service/constants"
+	"field-service/domain/models"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// dedupFieldSchedules soft deletes every field schedule that repeats the field, date and time of another one, so
+// the unique slot index can be created on a database that already holds duplicates. Held and booked schedules
+// are kept over the others, then the oldest one.
+func dedupFieldSchedules(db *gorm.DB) error {
+	migrator := db.Migrator()
+	if !migrator.HasTable(&models.FieldSchedule{}) {
+		return nil
+	}
+	if !migrator.HasColumn(&models.FieldSchedule{}, "DeletedAt") {
+		err := migrator.AddColumn(&models.FieldSchedule{}, "DeletedAt")
+		if err != nil {
+			return err
+		}
+	}
+
+	result := db.Exec(`UPDATE field_schedules
+		SET deleted_at = NOW()
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (
+				PARTITION BY field_id, date, time_id
+				ORDER BY CASE WHEN status IN (?, ?) THEN 0 ELSE 1 END, id
+			) AS position
+			FROM field_schedules
+			WHERE deleted_at IS NULL
+		) ranked
+		WHERE field_schedules.id = ranked.id AND ranked.position > 1`,
+		constants.Held, constants.Booked,
+	)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected > 0 {
+		logrus.Warnf("soft deleted %d duplicate field schedules", result.RowsAffected)
+	}
+	return nil
+}
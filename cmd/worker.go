@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"context"
+	"field-service/config"
+	"field-service/jobs"
+	"field-service/repositories"
+	"field-service/services"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/joho/godotenv"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+)
+
+var workerCommand = &cobra.Command{
+	Use:   "worker",
+	Short: "Start the background scheduler",
+	Run: func(c *cobra.Command, args []string) {
+		_ = godotenv.Load()
+		config.Init()
+		db, err := config.InitDatabase()
+		if err != nil {
+			panic(err)
+		}
+
+		repository := repositories.NewRepositoryRegistry(db)
+		service := services.NewServiceRegistry(repository, nil)
+		scheduler := jobs.NewScheduler(repository, service)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		logrus.Info("worker started")
+		scheduler.Start(ctx)
+		logrus.Info("worker stopped")
+	},
+}
+
+func init() {
+	command.AddCommand(workerCommand)
+}
@@ -1,6 +1,7 @@
 package response
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
@@ -36,6 +37,10 @@ func HttpResponse(param ParamHTTPResp) {
 		})
 		return
 	}
+	if errors.Is(param.Error, errConst.ErrForbidden) {
+		param.Code = http.StatusForbidden
+	}
+
 	message := errConst.ErrInternalServerError.Error()
 	if param.Message != nil {
 		message = *param.Message
@@ -0,0 +1,25 @@
+package util
+
+import (
+	errTime "field-service/constants/error/time"
+	"fmt"
+	"time"
+)
+
+const MinutesPerDay = 24 * 60
+
+// ParseClock accepts HH:MM or HH:MM:SS and returns the value as HH:MM:SS with its minute of the day.
+func ParseClock(value string) (string, int, error) {
+	for _, layout := range []string{time.TimeOnly, "15:04"} {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return parsed.Format(time.TimeOnly), parsed.Hour()*60 + parsed.Minute(), nil
+		}
+	}
+	return "", 0, errTime.ErrInvalidTimeFormat
+}
+
+// FormatClock renders a minute of the day as HH:MM:SS.
+func FormatClock(minute int) string {
+	return fmt.Sprintf("%02d:%02d:00", minute/60, minute%60)
+}
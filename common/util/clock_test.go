@@ -0,0 +1,56 @@
+package util
+
+import (
+	"errors"
+	errTime "field-service/constants/error/time"
+	"testing"
+)
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		wantClock  string
+		wantMinute int
+		wantErr    error
+	}{
+		{name: "hours and minutes", value: "08:30", wantClock: "08:30:00", wantMinute: 510},
+		{name: "with seconds", value: "17:45:00", wantClock: "17:45:00", wantMinute: 1065},
+		{name: "midnight", value: "00:00", wantClock: "00:00:00", wantMinute: 0},
+		{name: "last minute of the day", value: "23:59:00", wantClock: "23:59:00", wantMinute: 1439},
+		{name: "hour out of range", value: "24:00", wantErr: errTime.ErrInvalidTimeFormat},
+		{name: "minute out of range", value: "10:60", wantErr: errTime.ErrInvalidTimeFormat},
+		{name: "missing minutes", value: "10", wantErr: errTime.ErrInvalidTimeFormat},
+		{name: "twelve hour clock", value: "10:00 PM", wantErr: errTime.ErrInvalidTimeFormat},
+		{name: "empty", value: "", wantErr: errTime.ErrInvalidTimeFormat},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, minute, err := ParseClock(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseClock(%q) error = %v, want %v", tt.value, err, tt.wantErr)
+			}
+			if clock != tt.wantClock || minute != tt.wantMinute {
+				t.Errorf("ParseClock(%q) = %q, %d, want %q, %d", tt.value, clock, minute, tt.wantClock, tt.wantMinute)
+			}
+		})
+	}
+}
+
+func TestFormatClock(t *testing.T) {
+	tests := []struct {
+		minute int
+		want   string
+	}{
+		{minute: 0, want: "00:00:00"},
+		{minute: 90, want: "01:30:00"},
+		{minute: 1439, want: "23:59:00"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatClock(tt.minute); got != tt.want {
+			t.Errorf("FormatClock(%d) = %q, want %q", tt.minute, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package util
+
+import (
+	errConstant "field-service/constants/error"
+	"strings"
+)
+
+// SortColumn is a sort key a list accepts. Expression is the SQL it orders by and Join the clause it needs, if any.
+type SortColumn struct {
+	Expression string
+	Join       string
+}
+
+// SortSpec is the allowlist of sort keys for one list. Default is used when no key is requested and TieBreaker
+// is always appended so pages stay stable when the sort keys tie.
+type SortSpec struct {
+	Columns    map[string]SortColumn
+	Default    string
+	TieBreaker string
+}
+
+type Sort struct {
+	Order string
+	Joins []string
+}
+
+// Parse builds the ORDER BY from comma separated sortColumn and sortOrder parameters, e.g. "date,start_time"
+// and "desc,asc". Orders pair with the columns by position; a single order applies to every column and a
+// missing one means asc. Unknown keys are rejected so nothing from the request reaches the SQL as is.
+func (s SortSpec) Parse(sortColumn *string, sortOrder *string) (*Sort, error) {
+	columns := splitList(sortColumn)
+	orders := splitList(sortOrder)
+	if len(columns) == 0 {
+		return &Sort{Order: s.withTieBreaker([]string{s.Default})}, nil
+	}
+	if len(orders) > len(columns) {
+		return nil, errConstant.ErrInvalidSortOrder
+	}
+
+	parts := make([]string, 0, len(columns)+1)
+	joins := make([]string, 0)
+	joined := make(map[string]bool)
+	for i, name := range columns {
+		column, ok := s.Columns[name]
+		if !ok {
+			return nil, errConstant.ErrInvalidSortColumn
+		}
+
+		direction := "asc"
+		switch {
+		case len(orders) == 1:
+			direction = strings.ToLower(orders[0])
+		case i < len(orders):
+			direction = strings.ToLower(orders[i])
+		}
+		if direction != "asc" && direction != "desc" {
+			return nil, errConstant.ErrInvalidSortOrder
+		}
+
+		parts = append(parts, column.Expression+" "+direction)
+		if column.Join != "" && !joined[column.Join] {
+			joined[column.Join] = true
+			joins = append(joins, column.Join)
+		}
+	}
+	return &Sort{Order: s.withTieBreaker(parts), Joins: joins}, nil
+}
+
+func (s SortSpec) withTieBreaker(parts []string) string {
+	if s.TieBreaker != "" {
+		parts = append(parts, s.TieBreaker)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func splitList(value *string) []string {
+	if value == nil || strings.TrimSpace(*value) == "" {
+		return nil
+	}
+
+	items := strings.Split(*value, ",")
+	for i := range items {
+		items[i] = strings.TrimSpace(items[i])
+	}
+	return items
+}
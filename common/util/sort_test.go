@@ -0,0 +1,109 @@
+package util
+
+import (
+	"errors"
+	errConstant "field-service/constants/error"
+	"testing"
+)
+
+var testSortSpec = SortSpec{
+	Columns: map[string]SortColumn{
+		"date":       {Expression: "field_schedules.date"},
+		"start_time": {Expression: "times.start_time", Join: "JOIN times ON times.id = field_schedules.time_id"},
+		"end_time":   {Expression: "times.end_time", Join: "JOIN times ON times.id = field_schedules.time_id"},
+	},
+	Default:    "field_schedules.date asc",
+	TieBreaker: "field_schedules.id asc",
+}
+
+func TestSortSpecParse(t *testing.T) {
+	tests := []struct {
+		name       string
+		sortColumn *string
+		sortOrder  *string
+		wantOrder  string
+		wantJoins  int
+		wantErr    error
+	}{
+		{
+			name:      "default when no column is requested",
+			wantOrder: "field_schedules.date asc, field_schedules.id asc",
+		},
+		{
+			name:       "blank column falls back to the default",
+			sortColumn: ptr("  "),
+			wantOrder:  "field_schedules.date asc, field_schedules.id asc",
+		},
+		{
+			name:       "missing order means asc",
+			sortColumn: ptr("date"),
+			wantOrder:  "field_schedules.date asc, field_schedules.id asc",
+		},
+		{
+			name:       "single order applies to every column",
+			sortColumn: ptr("date, start_time"),
+			sortOrder:  ptr("DESC"),
+			wantOrder:  "field_schedules.date desc, times.start_time desc, field_schedules.id asc",
+			wantJoins:  1,
+		},
+		{
+			name:       "orders pair with columns by position",
+			sortColumn: ptr("date,start_time,end_time"),
+			sortOrder:  ptr("desc,asc"),
+			wantOrder:  "field_schedules.date desc, times.start_time asc, times.end_time asc, field_schedules.id asc",
+			wantJoins:  1,
+		},
+		{
+			name:       "unknown column is rejected",
+			sortColumn: ptr("price"),
+			wantErr:    errConstant.ErrInvalidSortColumn,
+		},
+		{
+			name:       "raw sql is rejected",
+			sortColumn: ptr("date; DROP TABLE field_schedules"),
+			wantErr:    errConstant.ErrInvalidSortColumn,
+		},
+		{
+			name:       "expression instead of key is rejected",
+			sortColumn: ptr("field_schedules.date"),
+			wantErr:    errConstant.ErrInvalidSortColumn,
+		},
+		{
+			name:       "unknown order is rejected",
+			sortColumn: ptr("date"),
+			sortOrder:  ptr("sideways"),
+			wantErr:    errConstant.ErrInvalidSortOrder,
+		},
+		{
+			name:       "more orders than columns are rejected",
+			sortColumn: ptr("date"),
+			sortOrder:  ptr("asc,desc"),
+			wantErr:    errConstant.ErrInvalidSortOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sort, err := testSortSpec.Parse(tt.sortColumn, tt.sortOrder)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Parse() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Parse() unexpected error = %v", err)
+			}
+			if sort.Order != tt.wantOrder {
+				t.Errorf("Parse() order = %q, want %q", sort.Order, tt.wantOrder)
+			}
+			if len(sort.Joins) != tt.wantJoins {
+				t.Errorf("Parse() joins = %v, want %d", sort.Joins, tt.wantJoins)
+			}
+		})
+	}
+}
+
+func ptr(value string) *string {
+	return &value
+}
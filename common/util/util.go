@@ -23,13 +23,23 @@ type PaginationParam struct {
 }
 
 type PaginationResult struct {
-	TotalPage int         `json:"totalPage"`
-	TotalData int64       `json:"totalData"`
-	NextPage  *int        `json:"nextPage"`
-	PrevPage  *int        `json:"prevPage"`
-	Page      int         `json:"page"`
-	Limit     int         `json:"limit"`
-	Data      interface{} `json:"data"`
+	TotalPage  int         `json:"totalPage"`
+	TotalData  int64       `json:"totalData"`
+	NextPage   *int        `json:"nextPage"`
+	PrevPage   *int        `json:"prevPage"`
+	NextCursor *string     `json:"nextCursor,omitempty"`
+	PrevCursor *string     `json:"prevCursor,omitempty"`
+	Page       int         `json:"page"`
+	Limit      int         `json:"limit"`
+	Data       interface{} `json:"data"`
+}
+
+type CursorPaginationParam struct {
+	Count      *int64
+	Limit      int
+	NextCursor *string
+	PrevCursor *string
+	Data       interface{}
 }
 
 func GeneratePagination(params PaginationParam) PaginationResult {
@@ -58,6 +68,22 @@ func GeneratePagination(params PaginationParam) PaginationResult {
 
 }
 
+// GenerateCursorPagination fills the page numbers with nil since a cursor page has no position; the totals are
+// only set when the count was requested.
+func GenerateCursorPagination(params CursorPaginationParam) PaginationResult {
+	result := PaginationResult{
+		NextCursor: params.NextCursor,
+		PrevCursor: params.PrevCursor,
+		Limit:      params.Limit,
+		Data:       params.Data,
+	}
+	if params.Count != nil {
+		result.TotalData = *params.Count
+		result.TotalPage = int(math.Ceil(float64(*params.Count) / float64(params.Limit)))
+	}
+	return result
+}
+
 func GenerateSHA256(inputString string) string {
 	hash := sha256.New()
 	hash.Write([]byte(inputString))
@@ -31,6 +31,11 @@ type AppConfig struct {
 	GCSClientX509CertURL       string          `json:"gcsClientX509CertURL"`
 	GCSUniverseDomain          string          `json:"gcsUniverseDomain"`
 	GCSBucketName              string          `json:"gcsBucketName"`
+	Timezone                   string          `json:"timezone"`
+	HoldExpirationMinute       int             `json:"holdExpirationMinute"`
+	CancellationCutoffHour     int             `json:"cancellationCutoffHour"`
+	ScheduleHorizonDays        int             `json:"scheduleHorizonDays"`
+	Worker                     Worker          `json:"worker"`
 }
 
 type Database struct {
@@ -45,6 +50,12 @@ type Database struct {
 	MaxIdleTime           int    `json:"maxIdleTime"`
 }
 
+type Worker struct {
+	ExtendScheduleIntervalMinute int `json:"extendScheduleIntervalMinute"`
+	ExpireHoldIntervalSecond     int `json:"expireHoldIntervalSecond"`
+	PurgeScheduleIntervalMinute  int `json:"purgeScheduleIntervalMinute"`
+}
+
 type InternalService struct {
 	User User `json:"user"`
 }
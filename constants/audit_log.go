@@ -0,0 +1,18 @@
+package constants
+
+type AuditEntity string
+type AuditAction string
+
+const (
+	AuditField         AuditEntity = "field"
+	AuditFieldSchedule AuditEntity = "field_schedule"
+	AuditTime          AuditEntity = "time"
+)
+
+const (
+	AuditCreate       AuditAction = "create"
+	AuditUpdate       AuditAction = "update"
+	AuditDelete       AuditAction = "delete"
+	AuditRestore      AuditAction = "restore"
+	AuditStatusChange AuditAction = "status_change"
+)
@@ -1,5 +1,8 @@
 package constants
 
 const (
-	Token = "token"
+	Token       = "token"
+	User        = "user"
+	ServiceName = "serviceName"
+	RequestID   = "requestID"
 )
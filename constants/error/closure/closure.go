@@ -0,0 +1,13 @@
+package error
+
+import "errors"
+
+var (
+	ErrClosureNotFound = errors.New("closure not found")
+	ErrClosureScope    = errors.New("closure targets either a field or a venue, not both")
+)
+
+var ClosureErrors = []error{
+	ErrClosureNotFound,
+	ErrClosureScope,
+}
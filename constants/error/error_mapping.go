@@ -1,17 +1,25 @@
 package error
 
 import (
+	errClosure "field-service/constants/error/closure"
 	errField "field-service/constants/error/field"
 	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errPricingRule "field-service/constants/error/pricingrule"
+	errScheduleTemplate "field-service/constants/error/scheduletemplate"
 	errTime "field-service/constants/error/time"
+	errVenue "field-service/constants/error/venue"
 )
 
 func ErrMapping(err error) bool {
 	var (
-		GeneralErrors       = GeneralErrors
-		FieldErrors         = errField.FieldErrors
-		FieldScheduleErrors = errFieldSchedule.FieldScheduleErrors
-		TimeErrors          = errTime.TimeErrors
+		GeneralErrors          = GeneralErrors
+		FieldErrors            = errField.FieldErrors
+		FieldScheduleErrors    = errFieldSchedule.FieldScheduleErrors
+		TimeErrors             = errTime.TimeErrors
+		ScheduleTemplateErrors = errScheduleTemplate.ScheduleTemplateErrors
+		PricingRuleErrors      = errPricingRule.PricingRuleErrors
+		ClosureErrors          = errClosure.ClosureErrors
+		VenueErrors            = errVenue.VenueErrors
 	)
 
 	allErrors := make([]error, 0)
@@ -19,6 +27,10 @@ func ErrMapping(err error) bool {
 	allErrors = append(allErrors, FieldErrors...)
 	allErrors = append(allErrors, FieldScheduleErrors...)
 	allErrors = append(allErrors, TimeErrors...)
+	allErrors = append(allErrors, ScheduleTemplateErrors...)
+	allErrors = append(allErrors, PricingRuleErrors...)
+	allErrors = append(allErrors, ClosureErrors...)
+	allErrors = append(allErrors, VenueErrors...)
 
 	for _, item := range allErrors {
 		if err.Error() == item.Error() {
@@ -3,9 +3,25 @@ package error
 import "errors"
 
 var (
-	ErrFieldNotFound = errors.New("field not found")
+	ErrFieldNotFound         = errors.New("field not found")
+	ErrInvalidOperatingHours = errors.New("opening time, closing time and slot duration must be set together")
+	ErrInvalidSlotDuration   = errors.New("slot duration does not fit between opening and closing time")
+	ErrInvalidSportType      = errors.New("invalid sport type")
+	ErrInvalidSurface        = errors.New("invalid field surface")
+	ErrInvalidEnvironment    = errors.New("invalid field environment, expected indoor or outdoor")
+	ErrInvalidFacility       = errors.New("invalid field facility")
+	ErrInvalidPriceRange     = errors.New("invalid price range")
+	ErrInvalidCreatedRange   = errors.New("invalid created date range, expected YYYY-MM-DD")
 )
 
 var FieldErrors = []error{
 	ErrFieldNotFound,
+	ErrInvalidOperatingHours,
+	ErrInvalidSlotDuration,
+	ErrInvalidSportType,
+	ErrInvalidSurface,
+	ErrInvalidEnvironment,
+	ErrInvalidFacility,
+	ErrInvalidPriceRange,
+	ErrInvalidCreatedRange,
 }
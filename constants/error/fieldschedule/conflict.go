@@ -0,0 +1,19 @@
+package error
+
+// ConflictError reports the schedules that could not be taken because another request got them first.
+// It carries the same message as ErrFieldScheduleNotAvailable so the response mapping still exposes it.
+type ConflictError struct {
+	FieldScheduleIDs []string
+}
+
+func NewConflictError(fieldScheduleIDs []string) *ConflictError {
+	return &ConflictError{FieldScheduleIDs: fieldScheduleIDs}
+}
+
+func (e *ConflictError) Error() string {
+	return ErrFieldScheduleNotAvailable.Error()
+}
+
+func (e *ConflictError) Unwrap() error {
+	return ErrFieldScheduleNotAvailable
+}
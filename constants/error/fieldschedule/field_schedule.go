@@ -3,11 +3,53 @@ package error
 import "errors"
 
 var (
-	ErrFieldScheduleNotFound = errors.New("field schedule not found")
-	ErrFieldScheduleIsExist  = errors.New("field schedule already exist")
+	ErrFieldScheduleNotFound       = errors.New("field schedule not found")
+	ErrFieldScheduleIsExist        = errors.New("field schedule already exist")
+	ErrFieldScheduleNotAvailable   = errors.New("field schedule is not available")
+	ErrFieldScheduleNotHeld        = errors.New("field schedule is not held")
+	ErrFieldScheduleHoldExpired    = errors.New("field schedule hold has expired")
+	ErrInvalidDate                 = errors.New("invalid date, expected format YYYY-MM-DD")
+	ErrInvalidDateRange            = errors.New("invalid date range")
+	ErrDateInPast                  = errors.New("date must not be in the past")
+	ErrInvalidWeekday              = errors.New("invalid weekday")
+	ErrDuplicateWeekday            = errors.New("weekday is listed more than once")
+	ErrFieldScheduleDateClosed     = errors.New("field schedule date is closed")
+	ErrInvalidPriceRange           = errors.New("invalid price range")
+	ErrInvalidStatus               = errors.New("invalid field schedule status")
+	ErrInvalidCursor               = errors.New("invalid cursor")
+	ErrInvalidCustomerUUID         = errors.New("invalid customer uuid")
+	ErrFieldScheduleNotBooked      = errors.New("field schedule is not booked")
+	ErrFieldScheduleBooked         = errors.New("field schedule is booked, pass override to change it")
+	ErrFieldScheduleHeld           = errors.New("field schedule is held and cannot be moved")
+	ErrCancellationClosed          = errors.New("field schedule can no longer be cancelled")
+	ErrBookingReferenceMismatch    = errors.New("field schedule is booked under another booking reference")
+	ErrSameFieldSchedule           = errors.New("target field schedule must differ from the booked one")
+	ErrPriceDifference             = errors.New("target field schedule has a different price")
+	ErrFieldScheduleNotConsecutive = errors.New("field schedules must be consecutive slots of the same field and date")
 )
 
 var FieldScheduleErrors = []error{
 	ErrFieldScheduleNotFound,
 	ErrFieldScheduleIsExist,
+	ErrFieldScheduleNotAvailable,
+	ErrFieldScheduleNotHeld,
+	ErrFieldScheduleHoldExpired,
+	ErrInvalidDate,
+	ErrInvalidDateRange,
+	ErrDateInPast,
+	ErrInvalidWeekday,
+	ErrDuplicateWeekday,
+	ErrFieldScheduleDateClosed,
+	ErrInvalidPriceRange,
+	ErrInvalidStatus,
+	ErrInvalidCursor,
+	ErrInvalidCustomerUUID,
+	ErrFieldScheduleNotBooked,
+	ErrFieldScheduleBooked,
+	ErrFieldScheduleHeld,
+	ErrCancellationClosed,
+	ErrBookingReferenceMismatch,
+	ErrSameFieldSchedule,
+	ErrPriceDifference,
+	ErrFieldScheduleNotConsecutive,
 }
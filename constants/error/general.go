@@ -12,6 +12,8 @@ var (
 	ErrInvalidUploadFile   = errors.New("invalid upload file")
 	ErrSizeTooBig          = errors.New("size too big")
 	ErrForbidden           = errors.New("forbidden")
+	ErrInvalidSortColumn   = errors.New("invalid sort column")
+	ErrInvalidSortOrder    = errors.New("invalid sort order, expected asc or desc")
 )
 
 var GeneralErrors = []error{
@@ -21,4 +23,6 @@ var GeneralErrors = []error{
 	ErrUnauthorized,
 	ErrInvalidToken,
 	ErrForbidden,
+	ErrInvalidSortColumn,
+	ErrInvalidSortOrder,
 }
@@ -0,0 +1,17 @@
+package error
+
+import "errors"
+
+var (
+	ErrPricingRuleNotFound    = errors.New("pricing rule not found")
+	ErrInvalidPricingRuleType = errors.New("invalid pricing rule type")
+	ErrPricingRuleTimeID      = errors.New("time slot pricing rule requires timeID")
+	ErrPricingRuleDate        = errors.New("holiday and date pricing rules require date")
+)
+
+var PricingRuleErrors = []error{
+	ErrPricingRuleNotFound,
+	ErrInvalidPricingRuleType,
+	ErrPricingRuleTimeID,
+	ErrPricingRuleDate,
+}
@@ -0,0 +1,11 @@
+package error
+
+import "errors"
+
+var (
+	ErrScheduleTemplateNotFound = errors.New("schedule template not found")
+)
+
+var ScheduleTemplateErrors = []error{
+	ErrScheduleTemplateNotFound,
+}
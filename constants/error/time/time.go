@@ -3,9 +3,23 @@ package error
 import "errors"
 
 var (
-	ErrTimeNotFound = errors.New("time not found")
+	ErrTimeNotFound      = errors.New("time not found")
+	ErrInvalidTimeFormat = errors.New("invalid time format, expected HH:MM or HH:MM:SS")
+	ErrInvalidTimeRange  = errors.New("end time must be after start time unless the slot crosses midnight")
+	ErrTimeIsExist       = errors.New("time already exist")
+	ErrTimeOverlap       = errors.New("time overlaps an existing time")
+	ErrTimeInUse         = errors.New("time is used by held or booked schedules")
+	ErrTimeInactive      = errors.New("time is inactive")
+	ErrTimeOwnedByField  = errors.New("time is derived from a field's operating hours")
 )
 
 var TimeErrors = []error{
 	ErrTimeNotFound,
+	ErrInvalidTimeFormat,
+	ErrInvalidTimeRange,
+	ErrTimeIsExist,
+	ErrTimeOverlap,
+	ErrTimeInUse,
+	ErrTimeInactive,
+	ErrTimeOwnedByField,
 }
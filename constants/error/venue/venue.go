@@ -0,0 +1,15 @@
+package error
+
+import "errors"
+
+var (
+	ErrVenueNotFound   = errors.New("venue not found")
+	ErrInvalidTimezone = errors.New("invalid timezone, expected an IANA name such as Asia/Jakarta")
+	ErrVenueRequired   = errors.New("venue is required")
+)
+
+var VenueErrors = []error{
+	ErrVenueNotFound,
+	ErrInvalidTimezone,
+	ErrVenueRequired,
+}
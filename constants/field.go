@@ -0,0 +1,96 @@
+package constants
+
+type SportType string
+type FieldSurface string
+type FieldEnvironment string
+type FieldFacility string
+
+const (
+	Futsal     SportType = "futsal"
+	MiniSoccer SportType = "mini_soccer"
+	Badminton  SportType = "badminton"
+	Basketball SportType = "basketball"
+	Padel      SportType = "padel"
+)
+
+const (
+	SyntheticTurf FieldSurface = "synthetic_turf"
+	NaturalGrass  FieldSurface = "natural_grass"
+	Vinyl         FieldSurface = "vinyl"
+	Parquet       FieldSurface = "parquet"
+	Rubber        FieldSurface = "rubber"
+	Concrete      FieldSurface = "concrete"
+	Acrylic       FieldSurface = "acrylic"
+)
+
+const (
+	Indoor  FieldEnvironment = "indoor"
+	Outdoor FieldEnvironment = "outdoor"
+)
+
+const (
+	Lighting        FieldFacility = "lighting"
+	Parking         FieldFacility = "parking"
+	ChangingRoom    FieldFacility = "changing_room"
+	Shower          FieldFacility = "shower"
+	Toilet          FieldFacility = "toilet"
+	Locker          FieldFacility = "locker"
+	Canteen         FieldFacility = "canteen"
+	PrayerRoom      FieldFacility = "prayer_room"
+	Wifi            FieldFacility = "wifi"
+	Seating         FieldFacility = "seating"
+	EquipmentRental FieldFacility = "equipment_rental"
+)
+
+var validSportTypes = map[SportType]bool{
+	Futsal:     true,
+	MiniSoccer: true,
+	Badminton:  true,
+	Basketball: true,
+	Padel:      true,
+}
+
+var validFieldSurfaces = map[FieldSurface]bool{
+	SyntheticTurf: true,
+	NaturalGrass:  true,
+	Vinyl:         true,
+	Parquet:       true,
+	Rubber:        true,
+	Concrete:      true,
+	Acrylic:       true,
+}
+
+var validFieldEnvironments = map[FieldEnvironment]bool{
+	Indoor:  true,
+	Outdoor: true,
+}
+
+var validFieldFacilities = map[FieldFacility]bool{
+	Lighting:        true,
+	Parking:         true,
+	ChangingRoom:    true,
+	Shower:          true,
+	Toilet:          true,
+	Locker:          true,
+	Canteen:         true,
+	PrayerRoom:      true,
+	Wifi:            true,
+	Seating:         true,
+	EquipmentRental: true,
+}
+
+func (s SportType) IsValid() bool {
+	return validSportTypes[s]
+}
+
+func (f FieldSurface) IsValid() bool {
+	return validFieldSurfaces[f]
+}
+
+func (f FieldEnvironment) IsValid() bool {
+	return validFieldEnvironments[f]
+}
+
+func (f FieldFacility) IsValid() bool {
+	return validFieldFacilities[f]
+}
@@ -5,20 +5,61 @@ type FieldScheduleStatus int
 
 const (
 	Available FieldScheduleStatus = 100
+	Held      FieldScheduleStatus = 150
 	Booked    FieldScheduleStatus = 200
+	Blocked   FieldScheduleStatus = 500
 
 	AvailableString FieldScheduleStatusName = "Available"
+	HeldString      FieldScheduleStatusName = "Held"
 	BookedString    FieldScheduleStatusName = "Booked"
+	BlockedString   FieldScheduleStatusName = "Blocked"
+)
+
+// FieldScheduleChangeReason tells what made a schedule change status, so the history can tell a cancelled
+// booking from a released hold even though both leave the slot available.
+type FieldScheduleChangeReason string
+
+const (
+	ChangeBook       FieldScheduleChangeReason = "book"
+	ChangeHold       FieldScheduleChangeReason = "hold"
+	ChangeConfirm    FieldScheduleChangeReason = "confirm"
+	ChangeRelease    FieldScheduleChangeReason = "release"
+	ChangeCancel     FieldScheduleChangeReason = "cancel"
+	ChangeReschedule FieldScheduleChangeReason = "reschedule"
+	ChangeExpire     FieldScheduleChangeReason = "expire"
+)
+
+const (
+	// DefaultHoldExpirationMinute is used when neither the request nor the config sets a hold duration.
+	DefaultHoldExpirationMinute = 15
+	// MaxHoldExpirationMinute caps a hold, whether it comes from the request or the config. The hold request
+	// validation repeats it.
+	MaxHoldExpirationMinute = 60
+	// DefaultCancellationCutoffHour is how long before a slot starts a booking can last be cancelled when the
+	// config does not set it.
+	DefaultCancellationCutoffHour = 24
+	// DefaultGenerateScheduleDays is the generation window when no end date or number of days is given.
+	DefaultGenerateScheduleDays = 30
+	// MaxGenerateScheduleDays caps a single generation request.
+	MaxGenerateScheduleDays = 366
+	// DefaultScheduleHorizonDays is how far ahead schedule templates are materialized.
+	DefaultScheduleHorizonDays = 60
+	// MaxAvailabilitySearchDays caps the date range of a single availability search.
+	MaxAvailabilitySearchDays = 31
 )
 
 var mapFieldScheduleStatusIntToString = map[FieldScheduleStatus]FieldScheduleStatusName{
 	Available: AvailableString,
+	Held:      HeldString,
 	Booked:    BookedString,
+	Blocked:   BlockedString,
 }
 
 var mapFieldScheduleStatusStringToInt = map[FieldScheduleStatusName]FieldScheduleStatus{
 	AvailableString: Available,
+	HeldString:      Held,
 	BookedString:    Booked,
+	BlockedString:   Blocked,
 }
 
 func (f FieldScheduleStatus) GetStatusString() FieldScheduleStatusName {
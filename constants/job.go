@@ -0,0 +1,14 @@
+package constants
+
+const (
+	DefaultExtendScheduleIntervalMinute = 60
+	DefaultExpireHoldIntervalSecond     = 60
+	DefaultPurgeScheduleIntervalMinute  = 1440
+)
+
+// Advisory lock keys shared by every worker replica, one per job.
+const (
+	ExtendScheduleLockKey int64 = 4201
+	ExpireHoldLockKey     int64 = 4202
+	PurgeScheduleLockKey  int64 = 4203
+)
@@ -0,0 +1,25 @@
+package constants
+
+type PricingRuleType string
+
+const (
+	// TimeSlotPricing sets the price of one Time slot, e.g. peak or off-peak hours.
+	TimeSlotPricing PricingRuleType = "time_slot"
+	// WeekendPricing adds a surcharge percentage on Saturday and Sunday.
+	WeekendPricing PricingRuleType = "weekend"
+	// HolidayPricing adds a surcharge percentage on a specific date.
+	HolidayPricing PricingRuleType = "holiday"
+	// DatePricing overrides the price on a specific date, optionally for a single Time slot.
+	DatePricing PricingRuleType = "date"
+)
+
+var validPricingRuleTypes = map[PricingRuleType]bool{
+	TimeSlotPricing: true,
+	WeekendPricing:  true,
+	HolidayPricing:  true,
+	DatePricing:     true,
+}
+
+func (p PricingRuleType) IsValid() bool {
+	return validPricingRuleTypes[p]
+}
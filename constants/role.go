@@ -1,6 +1,7 @@
 package constants
 
 const (
-	Admin    = "admin"
-	Customer = "customer"
+	Admin      = "admin"
+	Customer   = "customer"
+	VenueOwner = "venue_owner"
 )
@@ -0,0 +1,28 @@
+package constants
+
+import "time"
+
+const (
+	Sunday    = "sunday"
+	Monday    = "monday"
+	Tuesday   = "tuesday"
+	Wednesday = "wednesday"
+	Thursday  = "thursday"
+	Friday    = "friday"
+	Saturday  = "saturday"
+)
+
+var mapWeekdayNameToTime = map[string]time.Weekday{
+	Sunday:    time.Sunday,
+	Monday:    time.Monday,
+	Tuesday:   time.Tuesday,
+	Wednesday: time.Wednesday,
+	Thursday:  time.Thursday,
+	Friday:    time.Friday,
+	Saturday:  time.Saturday,
+}
+
+func GetWeekday(name string) (time.Weekday, bool) {
+	weekday, ok := mapWeekdayNameToTime[name]
+	return weekday, ok
+}
@@ -0,0 +1,69 @@
+package controllers
+
+import (
+	errCommon "field-service/common/error"
+	"field-service/common/response"
+	"field-service/domain/dto"
+	"field-service/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type AuditLogController struct {
+	service services.IServiceRegistry
+}
+
+type IAuditLogController interface {
+	GetAllWithPagination(*gin.Context)
+}
+
+func NewAuditLogController(service services.IServiceRegistry) IAuditLogController {
+	return &AuditLogController{
+		service: service,
+	}
+}
+
+// GetAllWithPagination implements IAuditLogController.
+func (a *AuditLogController) GetAllWithPagination(ctx *gin.Context) {
+	var params dto.AuditLogRequestParam
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(params); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errData,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := a.service.GetAuditLog().GetAllWithPagination(ctx, &params)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
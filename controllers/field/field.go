@@ -159,7 +159,30 @@ func (f *FieldController) GetByUUID(ctx *gin.Context) {
 }
 
 func (f *FieldController) GetAllWithoutPagination(ctx *gin.Context) {
-	result, err := f.service.GetField().GetAllWithoutPagination(ctx)
+	var params dto.FieldFilterParam
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+	validate := validator.New()
+	if err := validate.Struct(params); err != nil {
+		errorMessage := http.StatusText(http.StatusBadRequest)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusBadRequest,
+			Error:   err,
+			Message: &errorMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetField().GetAllWithoutPagination(ctx, &params)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code:  http.StatusBadRequest,
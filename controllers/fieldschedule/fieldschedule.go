@@ -1,8 +1,10 @@
 package controllers
 
 import (
+	"errors"
 	errCommon "field-service/common/error"
 	"field-service/common/response"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
 	"field-service/domain/dto"
 	"field-service/services"
 	"net/http"
@@ -20,7 +22,7 @@ type FieldScheduleController struct {
 // GenerateScheduleForOneMonth implements IFieldScheduleController.
 func (f *FieldScheduleController) GenerateScheduleForOneMonth(ctx *gin.Context) {
 	var params dto.GenerateFieldScheduleFromOneMonthRequest
-	if err := ctx.ShouldBindQuery(&params); err != nil {
+	if err := ctx.ShouldBindJSON(&params); err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code:  http.StatusBadRequest,
 			Error: err,
@@ -40,33 +42,89 @@ func (f *FieldScheduleController) GenerateScheduleForOneMonth(ctx *gin.Context)
 			Error:   err,
 			Gin:     ctx,
 		})
+		return
 	}
 
-	err := f.service.GetFieldSchedule().GenerateScheduleForOneMonth(ctx, &params)
+	result, err := f.service.GetFieldSchedule().GenerateScheduleForOneMonth(ctx, &params)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code:  http.StatusInternalServerError,
 			Gin:   ctx,
 			Error: err,
 		})
+		return
 	}
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
+		Data: result,
 		Gin:  ctx,
 	})
 
 }
 
+// GenerateSchedule implements IFieldScheduleController.
+func (f *FieldScheduleController) GenerateSchedule(ctx *gin.Context) {
+	var request dto.GenerateFieldScheduleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Message: &errMessage,
+			Data:    errData,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().GenerateSchedule(ctx, &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Gin:   ctx,
+			Error: err,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
 type IFieldScheduleController interface {
 	GetAllWithPagination(*gin.Context)
 	GetAllByFieldIDAndDate(*gin.Context)
+	SearchAvailability(*gin.Context)
 	GetByUUID(*gin.Context)
+	GetHistory(*gin.Context)
 	Create(*gin.Context)
 	Update(*gin.Context)
+	UpdatePrice(*gin.Context)
 	UpdateStatus(*gin.Context)
+	Hold(*gin.Context)
+	Confirm(*gin.Context)
+	Release(*gin.Context)
+	Cancel(*gin.Context)
+	Reschedule(*gin.Context)
+	Restore(*gin.Context)
 	Delete(*gin.Context)
 	GenerateScheduleForOneMonth(*gin.Context)
+	GenerateSchedule(*gin.Context)
 }
 
 func NewFieldScheduleController(service services.IServiceRegistry) IFieldScheduleController {
@@ -101,7 +159,7 @@ func (f *FieldScheduleController) Create(ctx *gin.Context) {
 		return
 	}
 
-	err := f.service.GetFieldSchedule().Create(ctx, &request)
+	result, err := f.service.GetFieldSchedule().Create(ctx, &request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code:  http.StatusInternalServerError,
@@ -113,6 +171,7 @@ func (f *FieldScheduleController) Create(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusCreated,
+		Data: result,
 		Gin:  ctx,
 	})
 }
@@ -137,6 +196,26 @@ func (f *FieldScheduleController) GetByUUID(ctx *gin.Context) {
 	})
 }
 
+// GetHistory implements IFieldScheduleController.
+func (f *FieldScheduleController) GetHistory(ctx *gin.Context) {
+	uuid := ctx.Param("uuid")
+	result, err := f.service.GetFieldSchedule().GetHistory(ctx, uuid)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
 // GetAllWithPagination implements IFieldScheduleController.
 func (f *FieldScheduleController) GetAllWithPagination(ctx *gin.Context) {
 	var params dto.FieldScheduleRequestParam
@@ -169,7 +248,7 @@ func (f *FieldScheduleController) GetAllWithPagination(ctx *gin.Context) {
 	result, err := f.service.GetFieldSchedule().GetAllWithPagination(ctx, &params)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
-			Code:  http.StatusInternalServerError,
+			Code:  http.StatusBadRequest,
 			Gin:   ctx,
 			Error: err,
 		})
@@ -185,6 +264,49 @@ func (f *FieldScheduleController) GetAllWithPagination(ctx *gin.Context) {
 
 }
 
+// SearchAvailability implements IFieldScheduleController.
+func (f *FieldScheduleController) SearchAvailability(ctx *gin.Context) {
+	var params dto.FieldAvailabilityRequestParam
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(params); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errData,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().SearchAvailability(ctx, &params)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
 // GetAllByFieldIDAndDate implements IFieldScheduleController.
 func (f *FieldScheduleController) GetAllByFieldIDAndDate(ctx *gin.Context) {
 	var params dto.FieldScheduleByFieldIDAndDateRequestParam
@@ -276,8 +398,82 @@ func (f *FieldScheduleController) Update(ctx *gin.Context) {
 	})
 }
 
+// UpdatePrice implements IFieldScheduleController.
+func (f *FieldScheduleController) UpdatePrice(ctx *gin.Context) {
+	var request dto.UpdateFieldSchedulePriceRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().UpdatePrice(ctx, ctx.Param("uuid"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+		Data: result,
+	})
+}
+
+// Restore implements IFieldScheduleController.
+func (f *FieldScheduleController) Restore(ctx *gin.Context) {
+	result, err := f.service.GetFieldSchedule().Restore(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
 func (f *FieldScheduleController) Delete(ctx *gin.Context) {
-	err := f.service.GetFieldSchedule().Delete(ctx, ctx.Param("uuid"))
+	var params dto.DeleteFieldScheduleRequestParam
+	if err := ctx.ShouldBindQuery(&params); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	err := f.service.GetFieldSchedule().Delete(ctx, ctx.Param("uuid"), &params)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code:  http.StatusBadRequest,
@@ -321,7 +517,103 @@ func (f *FieldScheduleController) UpdateStatus(ctx *gin.Context) {
 		return
 	}
 
-	err = f.service.GetFieldSchedule().UpdateStatus(ctx, &request)
+	result, err := f.service.GetFieldSchedule().UpdateStatus(ctx, &request)
+	if err != nil {
+		if responseConflict(ctx, err) {
+			return
+		}
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Hold implements IFieldScheduleController.
+func (f *FieldScheduleController) Hold(ctx *gin.Context) {
+	var request dto.HoldFieldScheduleRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().Hold(ctx, &request)
+	if err != nil {
+		if responseConflict(ctx, err) {
+			return
+		}
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Confirm implements IFieldScheduleController.
+func (f *FieldScheduleController) Confirm(ctx *gin.Context) {
+	var request dto.UpdateStatusScheduleRquest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().Confirm(ctx, &request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
 			Code:  http.StatusBadRequest,
@@ -333,6 +625,161 @@ func (f *FieldScheduleController) UpdateStatus(ctx *gin.Context) {
 
 	response.HttpResponse(response.ParamHTTPResp{
 		Code: http.StatusOK,
+		Data: result,
 		Gin:  ctx,
 	})
 }
+
+// Release implements IFieldScheduleController.
+func (f *FieldScheduleController) Release(ctx *gin.Context) {
+	var request dto.UpdateStatusScheduleRquest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().Release(ctx, &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Cancel implements IFieldScheduleController.
+func (f *FieldScheduleController) Cancel(ctx *gin.Context) {
+	var request dto.CancelFieldScheduleRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().Cancel(ctx, &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Reschedule implements IFieldScheduleController.
+func (f *FieldScheduleController) Reschedule(ctx *gin.Context) {
+	var request dto.RescheduleFieldScheduleRequest
+	err := ctx.ShouldBindJSON(&request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(request)
+	if err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errorResponse := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Error:   err,
+			Message: &errMessage,
+			Data:    errorResponse,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := f.service.GetFieldSchedule().Reschedule(ctx, &request)
+	if err != nil {
+		if responseConflict(ctx, err) {
+			return
+		}
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// responseConflict writes a 409 listing the taken schedules when err is a conflict error.
+func responseConflict(ctx *gin.Context, err error) bool {
+	var conflictErr *errFieldSchedule.ConflictError
+	if !errors.As(err, &conflictErr) {
+		return false
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code:  http.StatusConflict,
+		Error: err,
+		Data:  conflictErr.FieldScheduleIDs,
+		Gin:   ctx,
+	})
+	return true
+}
@@ -0,0 +1,172 @@
+package controllers
+
+import (
+	errCommon "field-service/common/error"
+	"field-service/common/response"
+	"field-service/domain/dto"
+	"field-service/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type PricingRuleController struct {
+	service services.IServiceRegistry
+}
+
+type IPricingRuleController interface {
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+}
+
+func NewPricingRuleController(service services.IServiceRegistry) IPricingRuleController {
+	return &PricingRuleController{
+		service: service,
+	}
+}
+
+// GetAll implements IPricingRuleController.
+func (p *PricingRuleController) GetAll(ctx *gin.Context) {
+	result, err := p.service.GetPricingRule().GetAllByFieldUUID(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// GetByUUID implements IPricingRuleController.
+func (p *PricingRuleController) GetByUUID(ctx *gin.Context) {
+	result, err := p.service.GetPricingRule().GetByUUID(ctx, ctx.Param("uuid"), ctx.Param("ruleUUID"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Create implements IPricingRuleController.
+func (p *PricingRuleController) Create(ctx *gin.Context) {
+	var request dto.PricingRuleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Data:    errData,
+			Message: &errMessage,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := p.service.GetPricingRule().Create(ctx, ctx.Param("uuid"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Update implements IPricingRuleController.
+func (p *PricingRuleController) Update(ctx *gin.Context) {
+	var request dto.PricingRuleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Data:    errData,
+			Message: &errMessage,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := p.service.GetPricingRule().Update(ctx, ctx.Param("uuid"), ctx.Param("ruleUUID"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Delete implements IPricingRuleController.
+func (p *PricingRuleController) Delete(ctx *gin.Context) {
+	err := p.service.GetPricingRule().Delete(ctx, ctx.Param("uuid"), ctx.Param("ruleUUID"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
@@ -1,9 +1,14 @@
 package controllers
 
 import (
+	auditLogController "field-service/controllers/auditlog"
+	closureController "field-service/controllers/closure"
 	fieldController "field-service/controllers/field"
 	fieldScheduleController "field-service/controllers/fieldschedule"
+	pricingRuleController "field-service/controllers/pricingrule"
+	scheduleTemplateController "field-service/controllers/scheduletemplate"
 	timeController "field-service/controllers/time"
+	venueController "field-service/controllers/venue"
 	"field-service/services"
 )
 
@@ -18,6 +23,11 @@ type IControllerRegistry interface {
 	GetFieldSchedule() fieldScheduleController.IFieldScheduleController
 
 	GetTime() timeController.ITimeController
+	GetScheduleTemplate() scheduleTemplateController.IScheduleTemplateController
+	GetPricingRule() pricingRuleController.IPricingRuleController
+	GetClosure() closureController.IClosureController
+	GetVenue() venueController.IVenueController
+	GetAuditLog() auditLogController.IAuditLogController
 }
 
 func NewControllerRegistry(services services.IServiceRegistry) IControllerRegistry {
@@ -40,3 +50,28 @@ func (r *Registry) GetFieldSchedule() fieldScheduleController.IFieldScheduleCont
 func (r *Registry) GetTime() timeController.ITimeController {
 	return timeController.NewTimeController(r.services)
 }
+
+// GetScheduleTemplate implements IControllerRegistry.
+func (r *Registry) GetScheduleTemplate() scheduleTemplateController.IScheduleTemplateController {
+	return scheduleTemplateController.NewScheduleTemplateController(r.services)
+}
+
+// GetPricingRule implements IControllerRegistry.
+func (r *Registry) GetPricingRule() pricingRuleController.IPricingRuleController {
+	return pricingRuleController.NewPricingRuleController(r.services)
+}
+
+// GetClosure implements IControllerRegistry.
+func (r *Registry) GetClosure() closureController.IClosureController {
+	return closureController.NewClosureController(r.services)
+}
+
+// GetVenue implements IControllerRegistry.
+func (r *Registry) GetVenue() venueController.IVenueController {
+	return venueController.NewVenueController(r.services)
+}
+
+// GetAuditLog implements IControllerRegistry.
+func (r *Registry) GetAuditLog() auditLogController.IAuditLogController {
+	return auditLogController.NewAuditLogController(r.services)
+}
@@ -0,0 +1,192 @@
+package controllers
+
+import (
+	errCommon "field-service/common/error"
+	"field-service/common/response"
+	"field-service/domain/dto"
+	"field-service/services"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/validator/v10"
+)
+
+type ScheduleTemplateController struct {
+	service services.IServiceRegistry
+}
+
+type IScheduleTemplateController interface {
+	GetAll(*gin.Context)
+	GetByUUID(*gin.Context)
+	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
+	Materialize(*gin.Context)
+}
+
+func NewScheduleTemplateController(service services.IServiceRegistry) IScheduleTemplateController {
+	return &ScheduleTemplateController{
+		service: service,
+	}
+}
+
+// GetAll implements IScheduleTemplateController.
+func (s *ScheduleTemplateController) GetAll(ctx *gin.Context) {
+	result, err := s.service.GetScheduleTemplate().GetAllByFieldUUID(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// GetByUUID implements IScheduleTemplateController.
+func (s *ScheduleTemplateController) GetByUUID(ctx *gin.Context) {
+	result, err := s.service.GetScheduleTemplate().GetByUUID(ctx, ctx.Param("uuid"), ctx.Param("templateUUID"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Create implements IScheduleTemplateController.
+func (s *ScheduleTemplateController) Create(ctx *gin.Context) {
+	var request dto.ScheduleTemplateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Data:    errData,
+			Message: &errMessage,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := s.service.GetScheduleTemplate().Create(ctx, ctx.Param("uuid"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusCreated,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Update implements IScheduleTemplateController.
+func (s *ScheduleTemplateController) Update(ctx *gin.Context) {
+	var request dto.ScheduleTemplateRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Data:    errData,
+			Message: &errMessage,
+			Error:   err,
+			Gin:     ctx,
+		})
+		return
+	}
+
+	result, err := s.service.GetScheduleTemplate().Update(ctx, ctx.Param("uuid"), ctx.Param("templateUUID"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Delete implements IScheduleTemplateController.
+func (s *ScheduleTemplateController) Delete(ctx *gin.Context) {
+	err := s.service.GetScheduleTemplate().Delete(ctx, ctx.Param("uuid"), ctx.Param("templateUUID"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
+
+// Materialize implements IScheduleTemplateController.
+func (s *ScheduleTemplateController) Materialize(ctx *gin.Context) {
+	result, err := s.service.GetScheduleTemplate().Materialize(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
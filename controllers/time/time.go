@@ -19,6 +19,8 @@ type ITimeController interface {
 	GetAll(*gin.Context)
 	GetByUUID(*gin.Context)
 	Create(*gin.Context)
+	Update(*gin.Context)
+	Delete(*gin.Context)
 }
 
 func NewTimeController(service services.IServiceRegistry) ITimeController {
@@ -36,6 +38,7 @@ func (t *TimeController) Create(ctx *gin.Context) {
 			Error: err,
 			Gin:   ctx,
 		})
+		return
 	}
 
 	validate := validator.New()
@@ -55,7 +58,7 @@ func (t *TimeController) Create(ctx *gin.Context) {
 	result, err := t.service.GetTime().Create(ctx, &request)
 	if err != nil {
 		response.HttpResponse(response.ParamHTTPResp{
-			Code:  http.StatusInternalServerError,
+			Code:  http.StatusBadRequest,
 			Error: err,
 			Gin:   ctx,
 		})
@@ -107,3 +110,64 @@ func (t *TimeController) GetByUUID(ctx *gin.Context) {
 		Gin:  ctx,
 	})
 }
+
+// Update implements ITimeController.
+func (t *TimeController) Update(ctx *gin.Context) {
+	var request dto.UpdateTimeRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	validate := validator.New()
+	if err := validate.Struct(request); err != nil {
+		errMessage := http.StatusText(http.StatusUnprocessableEntity)
+		errData := errCommon.ErrValidationResponse(err)
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:    http.StatusUnprocessableEntity,
+			Data:    errData,
+			Gin:     ctx,
+			Message: &errMessage,
+			Error:   err,
+		})
+		return
+	}
+
+	result, err := t.service.GetTime().Update(ctx, ctx.Param("uuid"), &request)
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Data: result,
+		Gin:  ctx,
+	})
+}
+
+// Delete implements ITimeController.
+func (t *TimeController) Delete(ctx *gin.Context) {
+	err := t.service.GetTime().Delete(ctx, ctx.Param("uuid"))
+	if err != nil {
+		response.HttpResponse(response.ParamHTTPResp{
+			Code:  http.StatusBadRequest,
+			Error: err,
+			Gin:   ctx,
+		})
+		return
+	}
+
+	response.HttpResponse(response.ParamHTTPResp{
+		Code: http.StatusOK,
+		Gin:  ctx,
+	})
+}
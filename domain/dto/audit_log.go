@@ -0,0 +1,34 @@
+package dto
+
+import (
+	"encoding/json"
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type AuditLogRequestParam struct {
+	Page        int     `form:"page" validate:"required"`
+	Limit       int     `form:"limit" validate:"required"`
+	EntityType  *string `form:"entityType"`
+	EntityUUID  *string `form:"entityUUID" validate:"omitempty,uuid"`
+	Action      *string `form:"action"`
+	ActorUUID   *string `form:"actorUUID" validate:"omitempty,uuid"`
+	ServiceName *string `form:"serviceName"`
+}
+
+type AuditLogResponse struct {
+	UUID        uuid.UUID             `json:"uuid"`
+	EntityType  constants.AuditEntity `json:"entityType"`
+	EntityUUID  uuid.UUID             `json:"entityUUID"`
+	Action      constants.AuditAction `json:"action"`
+	ActorUUID   *uuid.UUID            `json:"actorUUID,omitempty"`
+	ActorName   *string               `json:"actorName,omitempty"`
+	ActorRole   *string               `json:"actorRole,omitempty"`
+	ServiceName *string               `json:"serviceName,omitempty"`
+	RequestID   *string               `json:"requestID,omitempty"`
+	Before      json.RawMessage       `json:"before,omitempty"`
+	After       json.RawMessage       `json:"after,omitempty"`
+	CreatedAt   *time.Time            `json:"createdAt"`
+}
@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ClosureRequest struct {
+	FieldID   string `json:"fieldID"`
+	VenueID   string `json:"venueID"`
+	StartDate string `json:"startDate" validate:"required"`
+	EndDate   string `json:"endDate" validate:"required"`
+	Reason    string `json:"reason" validate:"required"`
+}
+
+type ClosureResponse struct {
+	UUID                 uuid.UUID               `json:"uuid"`
+	FieldName            *string                 `json:"fieldName"`
+	VenueName            *string                 `json:"venueName"`
+	StartDate            string                  `json:"startDate"`
+	EndDate              string                  `json:"endDate"`
+	Reason               string                  `json:"reason"`
+	BlockedSchedules     int64                   `json:"blockedSchedules,omitempty"`
+	ConflictingSchedules []FieldScheduleResponse `json:"conflictingSchedules,omitempty"`
+	CreatedAt            *time.Time              `json:"createdAt"`
+	UpdatedAt            *time.Time              `json:"updatedAt"`
+}
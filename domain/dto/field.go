@@ -1,6 +1,7 @@
 package dto
 
 import (
+	"field-service/constants"
 	"mime/multipart"
 	"time"
 
@@ -8,27 +9,64 @@ import (
 )
 
 type FieldRequest struct {
-	Name         string                 `form:"name" validate:"required"`
-	Code         string                 `form:"code" validate:"required"`
-	PricePerHour int                    `form:"pricePerHour" validate:"required"`
-	Images       []multipart.FileHeader `form:"images" validate:"required"`
+	Name               string                 `form:"name" validate:"required"`
+	Code               string                 `form:"code" validate:"required"`
+	PricePerHour       int                    `form:"pricePerHour" validate:"required"`
+	Images             []multipart.FileHeader `form:"images" validate:"required"`
+	VenueID            string                 `form:"venueID"`
+	OpeningTime        string                 `form:"openingTime"`
+	ClosingTime        string                 `form:"closingTime"`
+	SlotDurationMinute int                    `form:"slotDurationMinute" validate:"omitempty,min=5,max=1440"`
+	Timezone           string                 `form:"timezone"`
+	SportType          string                 `form:"sportType"`
+	Surface            string                 `form:"surface"`
+	Environment        string                 `form:"environment"`
+	Capacity           *int                   `form:"capacity" validate:"omitempty,min=1"`
+	LengthMeter        *float64               `form:"lengthMeter" validate:"omitempty,gt=0"`
+	WidthMeter         *float64               `form:"widthMeter" validate:"omitempty,gt=0"`
+	Facilities         []string               `form:"facilities"`
 }
 
 type UpdateFieldRequest struct {
-	Name         string                 `form:"name" validate:"required"`
-	Code         string                 `form:"code" validate:"required"`
-	PricePerHour int                    `form:"pricePerHour" validate:"required"`
-	Images       []multipart.FileHeader `form:"images"`
+	Name               string                 `form:"name" validate:"required"`
+	Code               string                 `form:"code" validate:"required"`
+	PricePerHour       int                    `form:"pricePerHour" validate:"required"`
+	Images             []multipart.FileHeader `form:"images"`
+	VenueID            string                 `form:"venueID"`
+	OpeningTime        string                 `form:"openingTime"`
+	ClosingTime        string                 `form:"closingTime"`
+	SlotDurationMinute int                    `form:"slotDurationMinute" validate:"omitempty,min=5,max=1440"`
+	Timezone           string                 `form:"timezone"`
+	SportType          string                 `form:"sportType"`
+	Surface            string                 `form:"surface"`
+	Environment        string                 `form:"environment"`
+	Capacity           *int                   `form:"capacity" validate:"omitempty,min=1"`
+	LengthMeter        *float64               `form:"lengthMeter" validate:"omitempty,gt=0"`
+	WidthMeter         *float64               `form:"widthMeter" validate:"omitempty,gt=0"`
+	Facilities         []string               `form:"facilities"`
 }
 
 type FieldResponse struct {
-	UUID         uuid.UUID `json:"uuid"`
-	Code         string    `json:"code"`
-	Name         string    `json:"name"`
-	PricePerHour any       `json:"pricePerHour"`
-	Images       []string  `json:"images"`
-	CreatedAt    *time.Time
-	UpdatedAt    *time.Time
+	UUID               uuid.UUID                  `json:"uuid"`
+	VenueUUID          *uuid.UUID                 `json:"venueUUID,omitempty"`
+	VenueName          *string                    `json:"venueName,omitempty"`
+	Code               string                     `json:"code"`
+	Name               string                     `json:"name"`
+	PricePerHour       any                        `json:"pricePerHour"`
+	Images             []string                   `json:"images"`
+	OpeningTime        *string                    `json:"openingTime,omitempty"`
+	ClosingTime        *string                    `json:"closingTime,omitempty"`
+	SlotDurationMinute *int                       `json:"slotDurationMinute,omitempty"`
+	Timezone           string                     `json:"timezone"`
+	SportType          constants.SportType        `json:"sportType,omitempty"`
+	Surface            constants.FieldSurface     `json:"surface,omitempty"`
+	Environment        constants.FieldEnvironment `json:"environment,omitempty"`
+	Capacity           *int                       `json:"capacity,omitempty"`
+	LengthMeter        *float64                   `json:"lengthMeter,omitempty"`
+	WidthMeter         *float64                   `json:"widthMeter,omitempty"`
+	Facilities         []string                   `json:"facilities"`
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
 }
 
 type FieldDetailResponse struct {
@@ -45,4 +83,21 @@ type FieldRequestParam struct {
 	Limit      int     `form:"limit" validate:"required"`
 	SortColumn *string `form:"sortColumn"`
 	SortOrder  *string `form:"sortOrder"`
+	FieldFilterParam
+}
+
+// FieldFilterParam narrows the field lists. Search matches the name or code, the created dates are inclusive and
+// every facility listed must be offered by the field.
+type FieldFilterParam struct {
+	Search      *string  `form:"search"`
+	MinPrice    *int     `form:"minPrice" validate:"omitempty,min=0"`
+	MaxPrice    *int     `form:"maxPrice" validate:"omitempty,min=0"`
+	CreatedFrom *string  `form:"createdFrom"`
+	CreatedTo   *string  `form:"createdTo"`
+	VenueID     *string  `form:"venueID" validate:"omitempty,uuid"`
+	SportType   *string  `form:"sportType"`
+	Surface     *string  `form:"surface"`
+	Environment *string  `form:"environment"`
+	MinCapacity *int     `form:"minCapacity" validate:"omitempty,min=1"`
+	Facilities  []string `form:"facilities"`
 }
@@ -18,13 +18,90 @@ type GenerateFieldScheduleFromOneMonthRequest struct {
 	FieldID string `json:"fieldID" validate:"required"`
 }
 
+type GenerateFieldScheduleRequest struct {
+	FieldID      string                   `json:"fieldID" validate:"required"`
+	StartDate    string                   `json:"startDate"`
+	EndDate      string                   `json:"endDate"`
+	NumberOfDays int                      `json:"numberOfDays"`
+	Weekdays     []WeekdayScheduleRequest `json:"weekdays" validate:"dive"`
+}
+
+type WeekdayScheduleRequest struct {
+	Weekday string   `json:"weekday" validate:"required"`
+	TimeIDs []string `json:"timeIDs"`
+}
+
+// UpdateFieldScheduleRequest moves a schedule. A booked schedule is only moved when Override is set.
 type UpdateFieldScheduleRequest struct {
-	Date   string `json:"date" validate:"required"`
-	TimeID string `json:"timeIDs" validate:"required"`
+	Date     string `json:"date" validate:"required"`
+	TimeID   string `json:"timeIDs" validate:"required"`
+	Override bool   `json:"override"`
+}
+
+// DeleteFieldScheduleRequestParam deletes a schedule. A booked schedule is only deleted when Override is set.
+type DeleteFieldScheduleRequestParam struct {
+	Override bool `form:"override"`
+}
+
+type UpdateFieldSchedulePriceRequest struct {
+	Price *int `json:"price" validate:"required,min=0"`
 }
 
+// UpdateStatusScheduleRquest books, confirms or releases schedules. The booking reference is the order the slots
+// are booked under and CustomerUUID the user who booked them; confirming a hold keeps the hold's customer when
+// it is left empty. A hold taken under a booking reference is only confirmed or released with that reference.
+// RequireConsecutive rejects slots that are not one unbroken run on the same field and date.
 type UpdateStatusScheduleRquest struct {
+	FieldScheduleIDs   []string `json:"fieldScheduleIDs" validate:"required"`
+	BookingReference   *string  `json:"bookingReference" validate:"omitempty,max=100"`
+	CustomerUUID       *string  `json:"customerUUID" validate:"omitempty,uuid"`
+	RequireConsecutive bool     `json:"requireConsecutive"`
+}
+
+type HoldFieldScheduleRequest struct {
+	FieldScheduleIDs   []string `json:"fieldScheduleIDs" validate:"required"`
+	HoldMinutes        int      `json:"holdMinutes" validate:"omitempty,min=1,max=60"`
+	BookingReference   *string  `json:"bookingReference" validate:"omitempty,max=100"`
+	CustomerUUID       *string  `json:"customerUUID" validate:"omitempty,uuid"`
+	RequireConsecutive bool     `json:"requireConsecutive"`
+}
+
+// CancelFieldScheduleRequest gives booked schedules back. When a booking reference is sent every schedule must be
+// booked under it.
+type CancelFieldScheduleRequest struct {
 	FieldScheduleIDs []string `json:"fieldScheduleIDs" validate:"required"`
+	BookingReference *string  `json:"bookingReference" validate:"omitempty,max=100"`
+}
+
+// RescheduleFieldScheduleRequest moves a booking to another available slot. The move is refused when the target
+// costs a different amount unless AcceptPriceDifference is set.
+type RescheduleFieldScheduleRequest struct {
+	FieldScheduleID       string  `json:"fieldScheduleID" validate:"required"`
+	TargetFieldScheduleID string  `json:"targetFieldScheduleID" validate:"required"`
+	BookingReference      *string `json:"bookingReference" validate:"omitempty,max=100"`
+	AcceptPriceDifference bool    `json:"acceptPriceDifference"`
+}
+
+// FieldScheduleRescheduleResponse reports the move; PriceDifference is what the target slot costs minus what the
+// original one did.
+type FieldScheduleRescheduleResponse struct {
+	FromFieldScheduleID uuid.UUID  `json:"fromFieldScheduleID"`
+	ToFieldScheduleID   uuid.UUID  `json:"toFieldScheduleID"`
+	BookingReference    *string    `json:"bookingReference"`
+	CustomerUUID        *uuid.UUID `json:"customerUUID"`
+	PriceDifference     int        `json:"priceDifference"`
+}
+
+// FieldScheduleHoldResponse reports the schedules that changed status. Booking, holding and confirming also
+// report the total duration and price of the slots. Slots given back on a closed date are blocked instead of
+// made available and listed in BlockedFieldScheduleIDs.
+type FieldScheduleHoldResponse struct {
+	FieldScheduleIDs        []uuid.UUID                       `json:"fieldScheduleIDs"`
+	Status                  constants.FieldScheduleStatusName `json:"status"`
+	HoldExpiresAt           *time.Time                        `json:"holdExpiresAt"`
+	BlockedFieldScheduleIDs []uuid.UUID                       `json:"blockedFieldScheduleIDs,omitempty"`
+	TotalDurationMinute     int                               `json:"totalDurationMinute,omitempty"`
+	TotalPrice              int                               `json:"totalPrice"`
 }
 
 type FieldScheduleResponse struct {
@@ -34,10 +111,36 @@ type FieldScheduleResponse struct {
 	Date         string                            `json:"date"`
 	Status       constants.FieldScheduleStatusName `json:"status"`
 	Time         string                            `json:"time"`
+	Booking      *FieldScheduleBookingDetail       `json:"booking,omitempty"`
 	CreatedAt    *time.Time                        `json:"createdAt"`
 	UpdatedAt    *time.Time                        `json:"updatedAt"`
 }
 
+// FieldScheduleBookingDetail tells who holds or booked a slot. It is only shown to admins and to the owner of the
+// field's venue.
+type FieldScheduleBookingDetail struct {
+	BookingReference *string    `json:"bookingReference"`
+	CustomerUUID     *uuid.UUID `json:"customerUUID"`
+	BookedAt         *time.Time `json:"bookedAt"`
+}
+
+type FieldScheduleHistoryResponse struct {
+	UUID             uuid.UUID                           `json:"uuid"`
+	FromStatus       constants.FieldScheduleStatusName   `json:"fromStatus"`
+	ToStatus         constants.FieldScheduleStatusName   `json:"toStatus"`
+	Reason           constants.FieldScheduleChangeReason `json:"reason"`
+	BookingReference *string                             `json:"bookingReference"`
+	CustomerUUID     *uuid.UUID                          `json:"customerUUID"`
+	ActorUUID        *uuid.UUID                          `json:"actorUUID"`
+	ServiceName      *string                             `json:"serviceName"`
+	CreatedAt        *time.Time                          `json:"createdAt"`
+}
+
+type GenerateFieldScheduleResponse struct {
+	Created int64 `json:"created"`
+	Skipped int64 `json:"skipped"`
+}
+
 type FieldScheduleBookingResponse struct {
 	UUID         uuid.UUID                         `json:"uuid"`
 	PricePerHour string                            `json:"pricePerHour"`
@@ -46,14 +149,59 @@ type FieldScheduleBookingResponse struct {
 	Time         string                            `json:"time"`
 }
 
+// FieldScheduleRequestParam pages through the schedules. FieldID and TimeID are UUIDs, the dates are inclusive
+// and Status is a status name such as Booked. Sending a cursor, empty for the first page, switches to cursor
+// pagination ordered by date, start time and id; Page and the sort are then ignored and the total is only
+// counted when WithTotal is set. OnlyDeleted lists the soft deleted schedules that can be restored.
+// BookingReference and CustomerUUID find the slots of an order or a customer.
 type FieldScheduleRequestParam struct {
-	Page       int     `form:"page" validate:"required"`
-	Limit      int     `form:"limit" validate:"required"`
-	SortColumn *string `form:"sortColumn"`
-	SortOrder  *string `form:"sortOrder"`
+	Page        int     `form:"page" validate:"required_without=Cursor"`
+	Limit       int     `form:"limit" validate:"required"`
+	Cursor      *string `form:"cursor"`
+	WithTotal   bool    `form:"withTotal"`
+	SortColumn  *string `form:"sortColumn"`
+	SortOrder   *string `form:"sortOrder"`
+	FieldID     *string `form:"fieldID" validate:"omitempty,uuid"`
+	StartDate   *string `form:"startDate"`
+	EndDate     *string `form:"endDate"`
+	Status      *string `form:"status"`
+	TimeID      *string `form:"timeID" validate:"omitempty,uuid"`
+	OnlyDeleted bool    `form:"onlyDeleted"`
+
+	BookingReference *string `form:"bookingReference"`
+	CustomerUUID     *string `form:"customerUUID" validate:"omitempty,uuid"`
+}
+
+// FieldScheduleCursor is the decoded form of the opaque cursor: the key of the row the page starts after, or
+// before when Backward is set.
+type FieldScheduleCursor struct {
+	Date      string `json:"d"`
+	StartTime string `json:"t"`
+	ID        uint   `json:"i"`
+	Backward  bool   `json:"b,omitempty"`
 }
 
 type FieldScheduleByFieldIDAndDateRequestParam struct {
 	FieldID uint   `form:"fieldID" validate:"required"`
 	Date    string `form:"date" validate:"required"`
 }
+
+type FieldAvailabilityRequestParam struct {
+	Date      string `form:"date"`
+	StartDate string `form:"startDate"`
+	EndDate   string `form:"endDate"`
+	StartTime string `form:"startTime"`
+	EndTime   string `form:"endTime"`
+	SportType string `form:"sportType"`
+	MinPrice  *int   `form:"minPrice" validate:"omitempty,min=0"`
+	MaxPrice  *int   `form:"maxPrice" validate:"omitempty,min=0"`
+	VenueID   string `form:"venueID" validate:"omitempty,uuid"`
+}
+
+type FieldAvailabilityResponse struct {
+	FieldUUID uuid.UUID                      `json:"fieldUUID"`
+	FieldName string                         `json:"fieldName"`
+	VenueUUID *uuid.UUID                     `json:"venueUUID,omitempty"`
+	VenueName *string                        `json:"venueName,omitempty"`
+	Slots     []FieldScheduleBookingResponse `json:"slots"`
+}
@@ -0,0 +1,31 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"field-service/constants"
+)
+
+type PricingRuleRequest struct {
+	Name             string                    `json:"name" validate:"required"`
+	Type             constants.PricingRuleType `json:"type" validate:"required"`
+	TimeID           string                    `json:"timeID"`
+	Date             string                    `json:"date"`
+	Price            int                       `json:"price" validate:"min=0"`
+	SurchargePercent int                       `json:"surchargePercent" validate:"min=0"`
+}
+
+type PricingRuleResponse struct {
+	UUID             uuid.UUID                 `json:"uuid"`
+	FieldName        string                    `json:"fieldName"`
+	Name             string                    `json:"name"`
+	Type             constants.PricingRuleType `json:"type"`
+	Time             *string                   `json:"time"`
+	Date             *string                   `json:"date"`
+	Price            int                       `json:"price"`
+	SurchargePercent int                       `json:"surchargePercent"`
+	CreatedAt        *time.Time                `json:"createdAt"`
+	UpdatedAt        *time.Time                `json:"updatedAt"`
+}
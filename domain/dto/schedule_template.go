@@ -0,0 +1,25 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ScheduleTemplateRequest struct {
+	Name     string   `json:"name" validate:"required"`
+	Weekdays []string `json:"weekdays" validate:"required,min=1"`
+	TimeIDs  []string `json:"timeIDs" validate:"required,min=1"`
+	IsActive *bool    `json:"isActive"`
+}
+
+type ScheduleTemplateResponse struct {
+	UUID      uuid.UUID      `json:"uuid"`
+	FieldName string         `json:"fieldName"`
+	Name      string         `json:"name"`
+	Weekdays  []string       `json:"weekdays"`
+	IsActive  bool           `json:"isActive"`
+	Times     []TimeResponse `json:"times"`
+	CreatedAt *time.Time     `json:"createdAt"`
+	UpdatedAt *time.Time     `json:"updatedAt"`
+}
@@ -7,14 +7,24 @@ import (
 )
 
 type TimeRequest struct {
-	StartTime string `json:"startTime" validate:"required"`
-	EndTime   string `json:"endTime" validate:"required"`
+	StartTime       string `json:"startTime" validate:"required"`
+	EndTime         string `json:"endTime" validate:"required"`
+	CrossesMidnight bool   `json:"crossesMidnight"`
+}
+
+type UpdateTimeRequest struct {
+	StartTime       string `json:"startTime" validate:"required"`
+	EndTime         string `json:"endTime" validate:"required"`
+	CrossesMidnight bool   `json:"crossesMidnight"`
+	IsActive        *bool  `json:"isActive"`
 }
 
 type TimeResponse struct {
-	UUID      uuid.UUID `json:"uuid"`
-	StartTime string    `json:"startTime" validate:"required"`
-	EndTime   string    `json:"endTime" validate:"required"`
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	UUID            uuid.UUID `json:"uuid"`
+	StartTime       string    `json:"startTime" validate:"required"`
+	EndTime         string    `json:"endTime" validate:"required"`
+	CrossesMidnight bool      `json:"crossesMidnight"`
+	IsActive        bool      `json:"isActive"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
 }
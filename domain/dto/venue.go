@@ -0,0 +1,36 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VenueRequest struct {
+	OwnerUUID   string   `json:"ownerUUID" validate:"required,uuid"`
+	Name        string   `json:"name" validate:"required"`
+	Address     string   `json:"address" validate:"required"`
+	City        string   `json:"city" validate:"required"`
+	Latitude    float64  `json:"latitude" validate:"min=-90,max=90"`
+	Longitude   float64  `json:"longitude" validate:"min=-180,max=180"`
+	PhoneNumber string   `json:"phoneNumber"`
+	Email       string   `json:"email" validate:"omitempty,email"`
+	Amenities   []string `json:"amenities"`
+	Timezone    string   `json:"timezone"`
+}
+
+type VenueResponse struct {
+	UUID        uuid.UUID  `json:"uuid"`
+	OwnerUUID   uuid.UUID  `json:"ownerUUID"`
+	Name        string     `json:"name"`
+	Address     string     `json:"address"`
+	City        string     `json:"city"`
+	Latitude    float64    `json:"latitude"`
+	Longitude   float64    `json:"longitude"`
+	PhoneNumber string     `json:"phoneNumber"`
+	Email       string     `json:"email"`
+	Amenities   []string   `json:"amenities"`
+	Timezone    string     `json:"timezone"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
@@ -0,0 +1,28 @@
+package models
+
+import (
+	"encoding/json"
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditLog records one mutation of a field, field schedule or time. The actor is the authenticated user when the
+// request carried a token, otherwise only the calling service is known. Before is empty on create and After on
+// delete.
+type AuditLog struct {
+	ID          uint                  `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID             `gorm:"type:uuid;not null"`
+	EntityType  constants.AuditEntity `gorm:"type:varchar(30);not null;index:idx_audit_logs_entity,priority:1"`
+	EntityUUID  uuid.UUID             `gorm:"type:uuid;not null;index:idx_audit_logs_entity,priority:2"`
+	Action      constants.AuditAction `gorm:"type:varchar(20);not null"`
+	ActorUUID   *uuid.UUID            `gorm:"type:uuid;index"`
+	ActorName   *string               `gorm:"type:varchar(100)"`
+	ActorRole   *string               `gorm:"type:varchar(30)"`
+	ServiceName *string               `gorm:"type:varchar(100)"`
+	RequestID   *string               `gorm:"type:varchar(100)"`
+	Before      json.RawMessage       `gorm:"type:jsonb"`
+	After       json.RawMessage       `gorm:"type:jsonb"`
+	CreatedAt   *time.Time            `gorm:"index"`
+}
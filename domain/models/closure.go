@@ -0,0 +1,23 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Closure blocks a date range for one field, for every field of a venue when only VenueID is set, or for every
+// field when both are nil.
+type Closure struct {
+	ID        uint      `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID `gorm:"type:uuid;not null"`
+	FieldID   *uint     `gorm:"type:int;index"`
+	VenueID   *uint     `gorm:"type:int;index"`
+	StartDate time.Time `gorm:"type:date;not null"`
+	EndDate   time.Time `gorm:"type:date;not null"`
+	Reason    string    `gorm:"type:varchar(255);not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	Field     *Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Venue     *Venue `gorm:"foreignKey:venue_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
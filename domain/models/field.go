@@ -1,6 +1,7 @@
 package models
 
 import (
+	"field-service/constants"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,14 +10,27 @@ import (
 )
 
 type Field struct {
-	ID            uint           `gorm:"primaryKey;autoIncrement"`
-	UUID          uuid.UUID      `gorm:"type:uuid;not null"`
-	Code          string         `gorm:"type:varchar(15);not null"`
-	Name          string         `gorm:"type:varchar(100);not null"`
-	PricePerHour  int            `gorm:"type:int;not null"`
-	Images        pq.StringArray `gorm:"type:text[]; not null"`
-	CreatedAt     *time.Time
-	UpdatedAt     *time.Time
-	DeletedAt     *gorm.DeletedAt
-	FieldSchedule []FieldSchedule `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	ID                 uint                       `gorm:"primaryKey;autoIncrement"`
+	UUID               uuid.UUID                  `gorm:"type:uuid;not null"`
+	VenueID            *uint                      `gorm:"type:int;index"`
+	Code               string                     `gorm:"type:varchar(15);not null"`
+	Name               string                     `gorm:"type:varchar(100);not null"`
+	PricePerHour       int                        `gorm:"type:int;not null"`
+	Images             pq.StringArray             `gorm:"type:text[]; not null"`
+	OpeningTime        *string                    `gorm:"type:time without time zone"`
+	ClosingTime        *string                    `gorm:"type:time without time zone"`
+	SlotDurationMinute *int                       `gorm:"type:int"`
+	Timezone           *string                    `gorm:"type:varchar(50)"`
+	SportType          constants.SportType        `gorm:"type:varchar(20);index"`
+	Surface            constants.FieldSurface     `gorm:"type:varchar(20)"`
+	Environment        constants.FieldEnvironment `gorm:"type:varchar(10)"`
+	Capacity           *int                       `gorm:"type:int"`
+	LengthMeter        *float64                   `gorm:"type:decimal(6,2)"`
+	WidthMeter         *float64                   `gorm:"type:decimal(6,2)"`
+	Facilities         pq.StringArray             `gorm:"type:text[]"`
+	CreatedAt          *time.Time
+	UpdatedAt          *time.Time
+	DeletedAt          *gorm.DeletedAt
+	Venue              *Venue          `gorm:"foreignKey:venue_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:SET NULL"`
+	FieldSchedule      []FieldSchedule `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
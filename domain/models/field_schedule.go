@@ -5,18 +5,30 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type FieldSchedule struct {
-	ID        uint                          `gorm:"primaryKey;autoIncrement"`
-	UUID      uuid.UUID                     `gorm:"type:uuid;not null"`
-	FieldID   uint                          `gorm:"type:int;not null"`
-	TimeID    uint                          `gorm:"type:int;not null"`
-	Date      time.Time                     `gorm:"type:date;not null"`
-	Status    constants.FieldScheduleStatus `gorm:"type:int;not null"`
+	ID            uint                          `gorm:"primaryKey;autoIncrement"`
+	UUID          uuid.UUID                     `gorm:"type:uuid;not null"`
+	FieldID       uint                          `gorm:"type:int;not null;uniqueIndex:idx_field_schedules_slot,priority:1,where:deleted_at IS NULL"`
+	TimeID        uint                          `gorm:"type:int;not null;uniqueIndex:idx_field_schedules_slot,priority:3"`
+	Date          time.Time                     `gorm:"type:date;not null;uniqueIndex:idx_field_schedules_slot,priority:2;index:idx_field_schedules_date"`
+	Status        constants.FieldScheduleStatus `gorm:"type:int;not null"`
+	Price         *int                          `gorm:"type:int"`
+	HoldExpiresAt *time.Time
+	Booking
 	CreatedAt *time.Time
 	UpdatedAt *time.Time
-	DeletedAt *time.Time
-	Field     Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	Time      Time  `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	DeletedAt *gorm.DeletedAt `gorm:"index"`
+	Field     Field           `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Time      Time            `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
+
+// Booking identifies who holds or booked a slot and under which order, so the order service can reconcile by
+// reference. It is cleared when the slot becomes available again.
+type Booking struct {
+	BookingReference *string    `gorm:"type:varchar(100);index"`
+	CustomerUUID     *uuid.UUID `gorm:"type:uuid;index"`
+	BookedAt         *time.Time
 }
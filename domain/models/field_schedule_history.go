@@ -0,0 +1,26 @@
+package models
+
+import (
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FieldScheduleHistory is one status transition of a field schedule, the reason it was made and the booking the
+// slot carried afterwards, or before for a release. Transitions made by the hold expiry have neither actor nor
+// service.
+type FieldScheduleHistory struct {
+	ID               uint                                `gorm:"primaryKey;autoIncrement"`
+	UUID             uuid.UUID                           `gorm:"type:uuid;not null"`
+	FieldScheduleID  uint                                `gorm:"type:int;not null;index"`
+	FromStatus       constants.FieldScheduleStatus       `gorm:"type:int;not null"`
+	ToStatus         constants.FieldScheduleStatus       `gorm:"type:int;not null"`
+	Reason           constants.FieldScheduleChangeReason `gorm:"type:varchar(20)"`
+	BookingReference *string                             `gorm:"type:varchar(100)"`
+	CustomerUUID     *uuid.UUID                          `gorm:"type:uuid"`
+	ActorUUID        *uuid.UUID                          `gorm:"type:uuid"`
+	ServiceName      *string                             `gorm:"type:varchar(100)"`
+	CreatedAt        *time.Time
+	FieldSchedule    FieldSchedule `gorm:"foreignKey:field_schedule_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
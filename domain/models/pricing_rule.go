@@ -0,0 +1,24 @@
+package models
+
+import (
+	"field-service/constants"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PricingRule struct {
+	ID               uint                      `gorm:"primaryKey;autoIncrement"`
+	UUID             uuid.UUID                 `gorm:"type:uuid;not null"`
+	FieldID          uint                      `gorm:"type:int;not null;index"`
+	Name             string                    `gorm:"type:varchar(100);not null"`
+	Type             constants.PricingRuleType `gorm:"type:varchar(20);not null"`
+	TimeID           *uint                     `gorm:"type:int"`
+	Date             *time.Time                `gorm:"type:date"`
+	Price            int                       `gorm:"type:int;not null"`
+	SurchargePercent int                       `gorm:"type:int;not null"`
+	CreatedAt        *time.Time
+	UpdatedAt        *time.Time
+	Field            Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Time             *Time `gorm:"foreignKey:time_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
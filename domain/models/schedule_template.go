@@ -0,0 +1,21 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+type ScheduleTemplate struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement"`
+	UUID      uuid.UUID      `gorm:"type:uuid;not null"`
+	FieldID   uint           `gorm:"type:int;not null;index"`
+	Name      string         `gorm:"type:varchar(100);not null"`
+	Weekdays  pq.StringArray `gorm:"type:text[];not null"`
+	IsActive  bool           `gorm:"not null"`
+	CreatedAt *time.Time
+	UpdatedAt *time.Time
+	Field     Field  `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	Times     []Time `gorm:"many2many:schedule_template_times;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+}
@@ -7,10 +7,14 @@ import (
 )
 
 type Time struct {
-	ID        uint      `gorm:"primaryKey;autoIncrement"`
-	UUID      uuid.UUID `gorm:"type:uuid;not null"`
-	StartTime string    `gorm:"type:time without time zone;not null"`
-	EndTime   string    `gorm:"type:time without time zone;not null"`
-	CreatedAt *time.Time
-	UpdatedAt *time.Time
+	ID              uint      `gorm:"primaryKey;autoIncrement"`
+	UUID            uuid.UUID `gorm:"type:uuid;not null"`
+	FieldID         *uint     `gorm:"type:int;index"`
+	StartTime       string    `gorm:"type:time without time zone;not null"`
+	EndTime         string    `gorm:"type:time without time zone;not null"`
+	CrossesMidnight bool      `gorm:"not null;default:false"`
+	IsActive        bool      `gorm:"not null;default:true"`
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	Field           *Field `gorm:"foreignKey:field_id;references:id;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
 }
@@ -0,0 +1,27 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"gorm.io/gorm"
+)
+
+type Venue struct {
+	ID          uint           `gorm:"primaryKey;autoIncrement"`
+	UUID        uuid.UUID      `gorm:"type:uuid;not null"`
+	OwnerUUID   uuid.UUID      `gorm:"type:uuid;not null;index"`
+	Name        string         `gorm:"type:varchar(100);not null"`
+	Address     string         `gorm:"type:text;not null"`
+	City        string         `gorm:"type:varchar(100);not null"`
+	Latitude    float64        `gorm:"type:decimal(10,7)"`
+	Longitude   float64        `gorm:"type:decimal(10,7)"`
+	PhoneNumber string         `gorm:"type:varchar(20)"`
+	Email       string         `gorm:"type:varchar(100)"`
+	Amenities   pq.StringArray `gorm:"type:text[]"`
+	Timezone    string         `gorm:"type:varchar(50);not null"`
+	CreatedAt   *time.Time
+	UpdatedAt   *time.Time
+	DeletedAt   *gorm.DeletedAt
+}
@@ -0,0 +1,128 @@
+package jobs
+
+import (
+	"context"
+	"field-service/config"
+	"field-service/constants"
+	"field-service/repositories"
+	"field-service/services"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+type Job struct {
+	Name     string
+	LockKey  int64
+	Interval time.Duration
+	Run      func(context.Context) error
+}
+
+type Scheduler struct {
+	repository repositories.IRepositoryRegistry
+	service    services.IServiceRegistry
+}
+
+type IScheduler interface {
+	Start(context.Context)
+}
+
+func NewScheduler(repository repositories.IRepositoryRegistry, service services.IServiceRegistry) IScheduler {
+	return &Scheduler{
+		repository: repository,
+		service:    service,
+	}
+}
+
+// Start runs every job once and then on its interval until ctx is cancelled.
+func (s *Scheduler) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, job := range s.jobs() {
+		wg.Add(1)
+		go func(job Job) {
+			defer wg.Done()
+			s.loop(ctx, job)
+		}(job)
+	}
+	wg.Wait()
+}
+
+func (s *Scheduler) jobs() []Job {
+	return []Job{
+		{
+			Name:     "extend-schedule",
+			LockKey:  constants.ExtendScheduleLockKey,
+			Interval: interval(config.Config.Worker.ExtendScheduleIntervalMinute, constants.DefaultExtendScheduleIntervalMinute, time.Minute),
+			Run: func(ctx context.Context) error {
+				result, err := s.service.GetFieldSchedule().GenerateFromTemplatesForAllFields(ctx)
+				if err != nil {
+					return err
+				}
+				logrus.Infof("extend-schedule: created %d, skipped %d", result.Created, result.Skipped)
+				return nil
+			},
+		},
+		{
+			Name:     "expire-hold",
+			LockKey:  constants.ExpireHoldLockKey,
+			Interval: interval(config.Config.Worker.ExpireHoldIntervalSecond, constants.DefaultExpireHoldIntervalSecond, time.Second),
+			Run: func(ctx context.Context) error {
+				released, err := s.service.GetFieldSchedule().ExpireHolds(ctx)
+				if err != nil {
+					return err
+				}
+				if released > 0 {
+					logrus.Infof("expire-hold: released %d", released)
+				}
+				return nil
+			},
+		},
+		{
+			Name:     "purge-schedule",
+			LockKey:  constants.PurgeScheduleLockKey,
+			Interval: interval(config.Config.Worker.PurgeScheduleIntervalMinute, constants.DefaultPurgeScheduleIntervalMinute, time.Minute),
+			Run: func(ctx context.Context) error {
+				purged, err := s.service.GetFieldSchedule().PurgePastUnbooked(ctx)
+				if err != nil {
+					return err
+				}
+				logrus.Infof("purge-schedule: purged %d", purged)
+				return nil
+			},
+		},
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.runOnce(ctx, job)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Scheduler) runOnce(ctx context.Context, job Job) {
+	acquired, err := s.repository.GetLock().WithAdvisoryLock(ctx, job.LockKey, job.Run)
+	if err != nil {
+		logrus.Errorf("%s: %v", job.Name, err)
+		return
+	}
+
+	if !acquired {
+		logrus.Debugf("%s: skipped, another worker holds the lock", job.Name)
+	}
+}
+
+func interval(value int, fallback int, unit time.Duration) time.Duration {
+	if value <= 0 {
+		value = fallback
+	}
+	return time.Duration(value) * unit
+}
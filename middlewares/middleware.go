@@ -18,6 +18,7 @@ import (
 	"github.com/didip/tollbooth"
 	"github.com/didip/tollbooth/limiter"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -37,6 +38,20 @@ func HandlePanic() gin.HandlerFunc {
 	}
 }
 
+// RequestID keeps the caller's x-request-id or generates one, so audit entries and logs can be correlated with
+// the request. The ID is echoed back in the response.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader(constants.XRequestID)
+		if requestID == "" {
+			requestID = uuid.NewString()
+		}
+		c.Set(constants.RequestID, requestID)
+		c.Header(constants.XRequestID, requestID)
+		c.Next()
+	}
+}
+
 func RateLimiter(lmt *limiter.Limiter) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		err := tollbooth.LimitByRequest(lmt, c.Writer, c.Request)
@@ -104,6 +119,7 @@ func CheckRole(roles []string, client clients.IClientRegistry) gin.HandlerFunc {
 			responseUnauthorized(c, errConstant.ErrUnauthorized.Error())
 			return
 		}
+		c.Set(constants.User, user)
 		c.Next()
 	}
 }
@@ -122,6 +138,7 @@ func Authenticate() gin.HandlerFunc {
 			responseUnauthorized(c, err.Error())
 			return
 		}
+		c.Set(constants.ServiceName, c.GetHeader(constants.XServiceName))
 
 		tokenString := extractBearerToken(token)
 		tokenUser := c.Request.WithContext(context.WithValue(c.Request.Context(), constants.Token, tokenString))
@@ -137,6 +154,7 @@ func AuthenticateWithoutToken() gin.HandlerFunc {
 			responseUnauthorized(c, err.Error())
 			return
 		}
+		c.Set(constants.ServiceName, c.GetHeader(constants.XServiceName))
 		c.Next()
 	}
 }
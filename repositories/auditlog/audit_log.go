@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type AuditLogRepository struct {
+	db *gorm.DB
+}
+
+type IAuditLogRepository interface {
+	FindAllWithPagination(context.Context, *dto.AuditLogRequestParam) ([]models.AuditLog, int64, error)
+	Create(context.Context, *gorm.DB, []models.AuditLog) error
+}
+
+func NewAuditLogRepository(db *gorm.DB) IAuditLogRepository {
+	return &AuditLogRepository{db: db}
+}
+
+// FindAllWithPagination returns the newest entries first.
+func (a *AuditLogRepository) FindAllWithPagination(
+	ctx context.Context,
+	param *dto.AuditLogRequestParam,
+) ([]models.AuditLog, int64, error) {
+	var (
+		auditLogs []models.AuditLog
+		total     int64
+	)
+	query := a.db.WithContext(ctx).Model(&models.AuditLog{})
+	if param.EntityType != nil {
+		query = query.Where("entity_type = ?", *param.EntityType)
+	}
+	if param.EntityUUID != nil {
+		query = query.Where("entity_uuid = ?", *param.EntityUUID)
+	}
+	if param.Action != nil {
+		query = query.Where("action = ?", *param.Action)
+	}
+	if param.ActorUUID != nil {
+		query = query.Where("actor_uuid = ?", *param.ActorUUID)
+	}
+	if param.ServiceName != nil {
+		query = query.Where("service_name = ?", *param.ServiceName)
+	}
+
+	limit := param.Limit
+	offset := (param.Page - 1) * limit
+	err := query.
+		Session(&gorm.Session{}).
+		Order("created_at desc").
+		Order("id desc").
+		Limit(limit).
+		Offset(offset).
+		Find(&auditLogs).
+		Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	err = query.Session(&gorm.Session{}).Count(&total).Error
+	if err != nil {
+		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return auditLogs, total, nil
+}
+
+func (a *AuditLogRepository) Create(ctx context.Context, tx *gorm.DB, auditLogs []models.AuditLog) error {
+	if len(auditLogs) == 0 {
+		return nil
+	}
+
+	for i := range auditLogs {
+		auditLogs[i].UUID = uuid.New()
+	}
+	err := tx.WithContext(ctx).Create(&auditLogs).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errClosure "field-service/constants/error/closure"
+	"field-service/domain/models"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ClosureRepository struct {
+	db *gorm.DB
+}
+
+type IClosureRepository interface {
+	FindAll(context.Context) ([]models.Closure, error)
+	FindByUUID(context.Context, string) (*models.Closure, error)
+	FindOverlapping(context.Context, *gorm.DB, *uint, *uint, time.Time, time.Time) ([]models.Closure, error)
+	Create(context.Context, *gorm.DB, *models.Closure) (*models.Closure, error)
+	Update(context.Context, *gorm.DB, string, *models.Closure) (*models.Closure, error)
+	Delete(context.Context, *gorm.DB, string) error
+}
+
+func NewClosureRepository(db *gorm.DB) IClosureRepository {
+	return &ClosureRepository{db: db}
+}
+
+func (c *ClosureRepository) FindAll(ctx context.Context) ([]models.Closure, error) {
+	var closures []models.Closure
+	err := c.db.
+		WithContext(ctx).
+		Preload("Field.Venue").
+		Preload("Venue").
+		Order("start_date desc").
+		Find(&closures).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return closures, nil
+}
+
+func (c *ClosureRepository) FindByUUID(ctx context.Context, uuid string) (*models.Closure, error) {
+	var closure models.Closure
+	err := c.db.
+		WithContext(ctx).
+		Preload("Field.Venue").
+		Preload("Venue").
+		Where("uuid = ?", uuid).
+		First(&closure).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errClosure.ErrClosureNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &closure, nil
+}
+
+// FindOverlapping returns the closures touching the date range. A nil fieldID matches every closure,
+// otherwise the field's own closures, the ones of its venue and the ones covering all fields are returned.
+func (c *ClosureRepository) FindOverlapping(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldID *uint,
+	venueID *uint,
+	startDate time.Time,
+	endDate time.Time,
+) ([]models.Closure, error) {
+	var closures []models.Closure
+	query := tx.
+		WithContext(ctx).
+		Where("start_date <= ?", endDate.Format(time.DateOnly)).
+		Where("end_date >= ?", startDate.Format(time.DateOnly))
+	if fieldID != nil && venueID != nil {
+		query = query.Where("field_id = ? OR (field_id IS NULL AND (venue_id IS NULL OR venue_id = ?))", *fieldID, *venueID)
+	} else if fieldID != nil {
+		query = query.Where("field_id = ? OR (field_id IS NULL AND venue_id IS NULL)", *fieldID)
+	}
+
+	err := query.Find(&closures).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return closures, nil
+}
+
+func (c *ClosureRepository) Create(ctx context.Context, tx *gorm.DB, req *models.Closure) (*models.Closure, error) {
+	closure := models.Closure{
+		UUID:      uuid.New(),
+		FieldID:   req.FieldID,
+		VenueID:   req.VenueID,
+		StartDate: req.StartDate,
+		EndDate:   req.EndDate,
+		Reason:    req.Reason,
+	}
+
+	err := tx.WithContext(ctx).Create(&closure).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &closure, nil
+}
+
+func (c *ClosureRepository) Update(
+	ctx context.Context,
+	tx *gorm.DB,
+	uuid string,
+	req *models.Closure,
+) (*models.Closure, error) {
+	closure, err := c.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	closure.FieldID = req.FieldID
+	closure.VenueID = req.VenueID
+	closure.StartDate = req.StartDate
+	closure.EndDate = req.EndDate
+	closure.Reason = req.Reason
+	closure.Field = req.Field
+	closure.Venue = req.Venue
+	err = tx.WithContext(ctx).Omit("Field", "Venue").Save(closure).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return closure, nil
+}
+
+func (c *ClosureRepository) Delete(ctx context.Context, tx *gorm.DB, uuid string) error {
+	err := tx.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Closure{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
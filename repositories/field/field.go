@@ -4,13 +4,15 @@ import (
 	"context"
 	"errors"
 	error2 "field-service/common/error"
+	"field-service/common/util"
 	errConst "field-service/constants/error"
 	errConstField "field-service/constants/error/field"
 	"field-service/domain/dto"
 	"field-service/domain/models"
-	"fmt"
+	"strings"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"gorm.io/gorm"
 )
 
@@ -20,13 +22,35 @@ type FieldRepository struct {
 
 type IFieldRepository interface {
 	FindAllWithPagination(context.Context, *dto.FieldRequestParam) ([]models.Field, int64, error)
-	FindAllWithoutPagination(context.Context) ([]models.Field, error)
+	FindAllWithoutPagination(context.Context, *dto.FieldFilterParam) ([]models.Field, error)
+	FindAllByVenueID(context.Context, *gorm.DB, uint) ([]models.Field, error)
 	FindByUUID(context.Context, string) (*models.Field, error)
-	Create(context.Context, *models.Field) (*models.Field, error)
-	Update(context.Context, string, *models.Field) (*models.Field, error)
-	Delete(context.Context, string) error
+	Create(context.Context, *gorm.DB, *models.Field) (*models.Field, error)
+	Update(context.Context, *gorm.DB, string, *models.Field) (*models.Field, error)
+	Delete(context.Context, *gorm.DB, string) error
 }
 
+var fieldSortSpec = util.SortSpec{
+	Columns: map[string]util.SortColumn{
+		"code":           {Expression: "fields.code"},
+		"name":           {Expression: "fields.name"},
+		"price_per_hour": {Expression: "fields.price_per_hour"},
+		"sport_type":     {Expression: "fields.sport_type"},
+		"capacity":       {Expression: "fields.capacity"},
+		"created_at":     {Expression: "fields.created_at"},
+		"updated_at":     {Expression: "fields.updated_at"},
+		"venue_name": {
+			Expression: "venues.name",
+			Join:       "LEFT JOIN venues ON venues.id = fields.venue_id",
+		},
+	},
+	Default:    "fields.created_at desc",
+	TieBreaker: "fields.id desc",
+}
+
+// likeEscaper makes the wildcards of a search term match literally in a LIKE pattern.
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
+
 func NewFieldRepository(db *gorm.DB) IFieldRepository {
 	return &FieldRepository{
 		db: db,
@@ -34,16 +58,28 @@ func NewFieldRepository(db *gorm.DB) IFieldRepository {
 }
 
 // Create implements IFieldRepository.
-func (f *FieldRepository) Create(ctx context.Context, req *models.Field) (*models.Field, error) {
+func (f *FieldRepository) Create(ctx context.Context, tx *gorm.DB, req *models.Field) (*models.Field, error) {
 	field := models.Field{
-		UUID:         uuid.New(),
-		Code:         req.Code,
-		Name:         req.Name,
-		Images:       req.Images,
-		PricePerHour: req.PricePerHour,
+		UUID:               uuid.New(),
+		VenueID:            req.VenueID,
+		Code:               req.Code,
+		Name:               req.Name,
+		Images:             req.Images,
+		PricePerHour:       req.PricePerHour,
+		OpeningTime:        req.OpeningTime,
+		ClosingTime:        req.ClosingTime,
+		SlotDurationMinute: req.SlotDurationMinute,
+		Timezone:           req.Timezone,
+		SportType:          req.SportType,
+		Surface:            req.Surface,
+		Environment:        req.Environment,
+		Capacity:           req.Capacity,
+		LengthMeter:        req.LengthMeter,
+		WidthMeter:         req.WidthMeter,
+		Facilities:         req.Facilities,
 	}
 
-	err := f.db.WithContext(ctx).Create(&field).Error
+	err := tx.WithContext(ctx).Create(&field).Error
 	if err != nil {
 		return nil, error2.WrapError(errConst.ErrSQLError)
 	}
@@ -51,15 +87,37 @@ func (f *FieldRepository) Create(ctx context.Context, req *models.Field) (*model
 }
 
 // Update implements IFieldRepository.
-func (f *FieldRepository) Update(ctx context.Context, UUID string, req *models.Field) (*models.Field, error) {
+func (f *FieldRepository) Update(ctx context.Context, tx *gorm.DB, UUID string, req *models.Field) (*models.Field, error) {
 	field := models.Field{
-		Code:         req.Code,
-		Name:         req.Name,
-		Images:       req.Images,
-		PricePerHour: req.PricePerHour,
+		VenueID:            req.VenueID,
+		Code:               req.Code,
+		Name:               req.Name,
+		Images:             req.Images,
+		PricePerHour:       req.PricePerHour,
+		OpeningTime:        req.OpeningTime,
+		ClosingTime:        req.ClosingTime,
+		SlotDurationMinute: req.SlotDurationMinute,
+		Timezone:           req.Timezone,
+		SportType:          req.SportType,
+		Surface:            req.Surface,
+		Environment:        req.Environment,
+		Capacity:           req.Capacity,
+		LengthMeter:        req.LengthMeter,
+		WidthMeter:         req.WidthMeter,
+		Facilities:         req.Facilities,
 	}
 
-	err := f.db.WithContext(ctx).Where("uuid = ?", UUID).Updates(&field).Error
+	err := tx.
+		WithContext(ctx).
+		Model(&models.Field{}).
+		Where("uuid = ?", UUID).
+		Select(
+			"venue_id", "code", "name", "images", "price_per_hour",
+			"opening_time", "closing_time", "slot_duration_minute", "timezone", "sport_type", "surface",
+			"environment", "capacity", "length_meter", "width_meter", "facilities", "updated_at",
+		).
+		Updates(&field).
+		Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, error2.WrapError(errConstField.ErrFieldNotFound)
@@ -70,8 +128,8 @@ func (f *FieldRepository) Update(ctx context.Context, UUID string, req *models.F
 }
 
 // Delete implements IFieldRepository.
-func (f *FieldRepository) Delete(ctx context.Context, UUID string) error {
-	err := f.db.WithContext(ctx).Where("uuid = ?", UUID).Delete(&models.Field{}).Error
+func (f *FieldRepository) Delete(ctx context.Context, tx *gorm.DB, UUID string) error {
+	err := tx.WithContext(ctx).Where("uuid = ?", UUID).Delete(&models.Field{}).Error
 	if err != nil {
 		return error2.WrapError(errConst.ErrSQLError)
 	}
@@ -80,23 +138,26 @@ func (f *FieldRepository) Delete(ctx context.Context, UUID string) error {
 
 // FindAllWithPagination implements IFieldRepository.
 func (f *FieldRepository) FindAllWithPagination(ctx context.Context, param *dto.FieldRequestParam) ([]models.Field, int64, error) {
-	var (
-		fields []models.Field
-		sort   string
-	)
-	if param.SortColumn != nil {
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		sort = "created_at desc"
+	var fields []models.Field
+	sort, err := fieldSortSpec.Parse(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, error2.WrapError(err)
+	}
+
+	query := filterFields(f.db.WithContext(ctx).Model(&models.Field{}), &param.FieldFilterParam)
+	data := query.Session(&gorm.Session{}).Preload("Venue")
+	for _, join := range sort.Joins {
+		data = data.Joins(join)
 	}
+
 	limit := param.Limit
 	offset := (param.Page - 1) * limit
-	err := f.db.WithContext(ctx).Limit(limit).Offset(offset).Order(sort).Find(&fields).Error
+	err = data.Limit(limit).Offset(offset).Order(sort.Order).Find(&fields).Error
 	if err != nil {
 		return nil, 0, error2.WrapError(errConst.ErrSQLError)
 	}
 	var total int64
-	err = f.db.WithContext(ctx).Model(&fields).Count(&total).Error
+	err = query.Session(&gorm.Session{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, error2.WrapError(errConst.ErrSQLError)
 	}
@@ -105,9 +166,61 @@ func (f *FieldRepository) FindAllWithPagination(ctx context.Context, param *dto.
 }
 
 // FindAllWithoutPagination implements IFieldRepository.
-func (f *FieldRepository) FindAllWithoutPagination(ctx context.Context) ([]models.Field, error) {
+func (f *FieldRepository) FindAllWithoutPagination(ctx context.Context, filter *dto.FieldFilterParam) ([]models.Field, error) {
+	var fields []models.Field
+	err := filterFields(f.db.WithContext(ctx), filter).Preload("Venue").Find(&fields).Error
+	if err != nil {
+		return nil, error2.WrapError(errConst.ErrSQLError)
+	}
+	return fields, nil
+}
+
+// filterFields applies the list filters to a field query. A nil filter leaves the query untouched.
+func filterFields(query *gorm.DB, filter *dto.FieldFilterParam) *gorm.DB {
+	if filter == nil {
+		return query
+	}
+	if filter.Search != nil && *filter.Search != "" {
+		pattern := "%" + likeEscaper.Replace(*filter.Search) + "%"
+		query = query.Where(`(fields.name ILIKE ? ESCAPE '\' OR fields.code ILIKE ? ESCAPE '\')`, pattern, pattern)
+	}
+	if filter.MinPrice != nil {
+		query = query.Where("fields.price_per_hour >= ?", *filter.MinPrice)
+	}
+	if filter.MaxPrice != nil {
+		query = query.Where("fields.price_per_hour <= ?", *filter.MaxPrice)
+	}
+	if filter.CreatedFrom != nil {
+		query = query.Where("fields.created_at >= CAST(? AS date)", *filter.CreatedFrom)
+	}
+	if filter.CreatedTo != nil {
+		query = query.Where("fields.created_at < CAST(? AS date) + INTERVAL '1 day'", *filter.CreatedTo)
+	}
+	if filter.VenueID != nil {
+		query = query.Where("fields.venue_id = (SELECT id FROM venues WHERE uuid = ?)", *filter.VenueID)
+	}
+	if filter.SportType != nil {
+		query = query.Where("fields.sport_type = ?", *filter.SportType)
+	}
+	if filter.Surface != nil {
+		query = query.Where("fields.surface = ?", *filter.Surface)
+	}
+	if filter.Environment != nil {
+		query = query.Where("fields.environment = ?", *filter.Environment)
+	}
+	if filter.MinCapacity != nil {
+		query = query.Where("fields.capacity >= ?", *filter.MinCapacity)
+	}
+	if len(filter.Facilities) > 0 {
+		query = query.Where("fields.facilities @> ?", pq.StringArray(filter.Facilities))
+	}
+	return query
+}
+
+// FindAllByVenueID implements IFieldRepository.
+func (f *FieldRepository) FindAllByVenueID(ctx context.Context, tx *gorm.DB, venueID uint) ([]models.Field, error) {
 	var fields []models.Field
-	err := f.db.WithContext(ctx).Find(&fields).Error
+	err := tx.WithContext(ctx).Where("venue_id = ?", venueID).Find(&fields).Error
 	if err != nil {
 		return nil, error2.WrapError(errConst.ErrSQLError)
 	}
@@ -117,7 +230,7 @@ func (f *FieldRepository) FindAllWithoutPagination(ctx context.Context) ([]model
 // FindByUUID implements IFieldRepository.
 func (f *FieldRepository) FindByUUID(ctx context.Context, UUID string) (*models.Field, error) {
 	var field models.Field
-	err := f.db.WithContext(ctx).Where("uuid = ?", UUID).First(&field).Error
+	err := f.db.WithContext(ctx).Preload("Venue").Where("uuid = ?", UUID).First(&field).Error
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, error2.WrapError(errConstField.ErrFieldNotFound)
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	errWrap "field-service/common/error"
+	"field-service/common/util"
 	"field-service/constants"
 	errConstant "field-service/constants/error"
 	errFieldSchedule "field-service/constants/error/fieldschedule"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"fmt"
+	"time"
 
+	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type FieldScheduleRepository struct {
@@ -20,15 +24,81 @@ type FieldScheduleRepository struct {
 
 type IFieldScheduleRepository interface {
 	FindAllWithPagination(context.Context, *dto.FieldScheduleRequestParam) ([]models.FieldSchedule, int64, error)
+	FindAllWithCursor(
+		context.Context, *dto.FieldScheduleRequestParam, *dto.FieldScheduleCursor, int,
+	) ([]models.FieldSchedule, error)
+	Count(context.Context, *dto.FieldScheduleRequestParam) (int64, error)
 	FindAllByFieldIDAndDate(context.Context, int, string) ([]models.FieldSchedule, error)
+	FindAllAvailable(context.Context, *dto.FieldAvailabilityRequestParam) ([]models.FieldSchedule, error)
 	FindByUUID(context.Context, string) (*models.FieldSchedule, error)
-	FindByDateAndTimeID(context.Context, string, int, int) (*models.FieldSchedule, error)
-	Create(context.Context, []models.FieldSchedule) error
-	Update(context.Context, string, *models.FieldSchedule) (*models.FieldSchedule, error)
-	UpdateStatus(context.Context, constants.FieldScheduleStatus, string) error
-	Delete(context.Context, string) error
+	FindDeletedByUUIDForUpdate(context.Context, *gorm.DB, string) (*models.FieldSchedule, error)
+	FindAllByUUIDsForUpdate(context.Context, *gorm.DB, []string) ([]models.FieldSchedule, error)
+	FindAllByIDs(context.Context, *gorm.DB, []uint) ([]models.FieldSchedule, error)
+	FindByDateAndTimeID(context.Context, *gorm.DB, string, int, int) (*models.FieldSchedule, error)
+	Create(context.Context, *gorm.DB, []models.FieldSchedule) ([]models.FieldSchedule, error)
+	Update(context.Context, *gorm.DB, string, *models.FieldSchedule) (*models.FieldSchedule, error)
+	UpdatePrice(context.Context, string, int) error
+	UpdateStatusByIDs(context.Context, *gorm.DB, []uint, constants.FieldScheduleStatus, *time.Time, *models.Booking) error
+	ReleaseExpiredHolds(context.Context) (int64, error)
+	CreateHistories(context.Context, *gorm.DB, []models.FieldScheduleHistory) error
+	FindAllHistoriesByFieldScheduleID(context.Context, uint) ([]models.FieldScheduleHistory, error)
+	DeletePastUnbooked(context.Context, *time.Location) (int64, error)
+	CountTakenByTimeID(context.Context, *gorm.DB, uint, *time.Location) (int64, error)
+	DeleteUnbookedByTimeID(context.Context, *gorm.DB, uint, *time.Location) (int64, error)
+	FindAllTakenByDateRange(context.Context, *gorm.DB, []uint, time.Time, time.Time) ([]models.FieldSchedule, error)
+	UpdateStatusByDateRange(
+		context.Context, *gorm.DB, []uint, time.Time, time.Time, constants.FieldScheduleStatus, constants.FieldScheduleStatus,
+	) (int64, error)
+	Restore(context.Context, *gorm.DB, string) error
+	Delete(context.Context, *gorm.DB, string) error
 }
 
+var fieldScheduleSortSpec = util.SortSpec{
+	Columns: map[string]util.SortColumn{
+		"date":       {Expression: "field_schedules.date"},
+		"status":     {Expression: "field_schedules.status"},
+		"created_at": {Expression: "field_schedules.created_at"},
+		"updated_at": {Expression: "field_schedules.updated_at"},
+		"price": {
+			Expression: "COALESCE(field_schedules.price, fields.price_per_hour)",
+			Join:       "LEFT JOIN fields ON fields.id = field_schedules.field_id",
+		},
+		"field_name": {
+			Expression: "fields.name",
+			Join:       "LEFT JOIN fields ON fields.id = field_schedules.field_id",
+		},
+		"start_time": {
+			Expression: "times.start_time",
+			Join:       "LEFT JOIN times ON times.id = field_schedules.time_id",
+		},
+	},
+	Default:    "field_schedules.created_at desc",
+	TieBreaker: "field_schedules.id desc",
+}
+
+// closedScheduleCondition matches a schedule dated inside a closure of its field, of its field's venue or of every
+// field, the same scopes ClosureRepository.FindOverlapping applies.
+const closedScheduleCondition = `EXISTS (
+	SELECT 1
+	FROM closures, fields
+	WHERE fields.id = field_schedules.field_id
+		AND closures.start_date <= field_schedules.date
+		AND closures.end_date >= field_schedules.date
+		AND (closures.field_id = fields.id
+			OR (closures.field_id IS NULL AND (closures.venue_id IS NULL OR closures.venue_id = fields.venue_id)))
+)`
+
+// fieldTodayQuery is the current date in the schedule's field timezone, then its venue's, then the bound default.
+const fieldTodayQuery = `(
+	SELECT (now() AT TIME ZONE COALESCE(NULLIF(fields.timezone, ''), NULLIF(venues.timezone, ''), ?))::date
+	FROM fields
+	LEFT JOIN venues ON venues.id = fields.venue_id
+	WHERE fields.id = field_schedules.field_id
+)`
+
+// createBatchSize keeps a generated window well below the bind parameter limit of a single insert.
+const createBatchSize = 500
+
 func NewFieldScheduleRepository(db *gorm.DB) IFieldScheduleRepository {
 	return &FieldScheduleRepository{db: db}
 }
@@ -39,35 +109,32 @@ func (f *FieldScheduleRepository) FindAllWithPagination(
 ) ([]models.FieldSchedule, int64, error) {
 	var (
 		fieldSchedules []models.FieldSchedule
-		sort           string
 		total          int64
 	)
-	if param.SortColumn != nil {
-		sort = fmt.Sprintf("%s %s", *param.SortColumn, *param.SortOrder)
-	} else {
-		sort = "created_at desc"
+	sort, err := fieldScheduleSortSpec.Parse(param.SortColumn, param.SortOrder)
+	if err != nil {
+		return nil, 0, errWrap.WrapError(err)
+	}
+
+	query := filterFieldSchedules(f.db.WithContext(ctx).Model(&models.FieldSchedule{}), param)
+	data := query.Session(&gorm.Session{}).Preload("Field.Venue").Preload("Time")
+	for _, join := range sort.Joins {
+		data = data.Joins(join)
 	}
 
 	limit := param.Limit
 	offset := (param.Page - 1) * limit
-	err := f.db.
-		WithContext(ctx).
-		Preload("Field").
-		Preload("Time").
+	err = data.
 		Limit(limit).
 		Offset(offset).
-		Order(sort).
+		Order(sort.Order).
 		Find(&fieldSchedules).
 		Error
 	if err != nil {
 		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 
-	err = f.db.
-		WithContext(ctx).
-		Model(&fieldSchedules).
-		Count(&total).
-		Error
+	err = query.Session(&gorm.Session{}).Count(&total).Error
 	if err != nil {
 		return nil, 0, errWrap.WrapError(errConstant.ErrSQLError)
 	}
@@ -75,6 +142,93 @@ func (f *FieldScheduleRepository) FindAllWithPagination(
 	return fieldSchedules, total, nil
 }
 
+// FindAllWithCursor returns up to limit schedules after, or before when the cursor is backward, the cursor key
+// in (date, start time, id) order. One extra row is fetched so the caller can tell whether more follow; the rows
+// always come back in ascending order.
+func (f *FieldScheduleRepository) FindAllWithCursor(
+	ctx context.Context,
+	param *dto.FieldScheduleRequestParam,
+	cursor *dto.FieldScheduleCursor,
+	limit int,
+) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	query := filterFieldSchedules(f.db.WithContext(ctx).Model(&models.FieldSchedule{}), param).
+		Preload("Field.Venue").
+		Preload("Time").
+		Joins("JOIN times ON times.id = field_schedules.time_id")
+
+	direction := "asc"
+	if cursor != nil {
+		operator := ">"
+		if cursor.Backward {
+			operator = "<"
+			direction = "desc"
+		}
+		query = query.Where(
+			fmt.Sprintf("(field_schedules.date, times.start_time, field_schedules.id) %s "+
+				"(CAST(? AS date), CAST(? AS time), ?)", operator),
+			cursor.Date, cursor.StartTime, cursor.ID,
+		)
+	}
+
+	err := query.
+		Order("field_schedules.date " + direction).
+		Order("times.start_time " + direction).
+		Order("field_schedules.id " + direction).
+		Limit(limit).
+		Find(&fieldSchedules).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	if direction == "desc" {
+		for i, j := 0, len(fieldSchedules)-1; i < j; i, j = i+1, j-1 {
+			fieldSchedules[i], fieldSchedules[j] = fieldSchedules[j], fieldSchedules[i]
+		}
+	}
+	return fieldSchedules, nil
+}
+
+func (f *FieldScheduleRepository) Count(ctx context.Context, param *dto.FieldScheduleRequestParam) (int64, error) {
+	var total int64
+	err := filterFieldSchedules(f.db.WithContext(ctx).Model(&models.FieldSchedule{}), param).Count(&total).Error
+	if err != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return total, nil
+}
+
+// filterFieldSchedules applies the list filters shared by the offset and cursor pages and their counts.
+func filterFieldSchedules(query *gorm.DB, param *dto.FieldScheduleRequestParam) *gorm.DB {
+	if param.OnlyDeleted {
+		query = query.Unscoped().Where("field_schedules.deleted_at IS NOT NULL")
+	}
+	if param.FieldID != nil {
+		query = query.Where("field_schedules.field_id = (SELECT id FROM fields WHERE uuid = ?)", *param.FieldID)
+	}
+	if param.StartDate != nil {
+		query = query.Where("field_schedules.date >= ?", *param.StartDate)
+	}
+	if param.EndDate != nil {
+		query = query.Where("field_schedules.date <= ?", *param.EndDate)
+	}
+	if param.Status != nil {
+		status := constants.FieldScheduleStatusName(*param.Status).GetStatusInt()
+		query = query.Where("field_schedules.status = ?", status)
+	}
+	if param.TimeID != nil {
+		query = query.Where("field_schedules.time_id = (SELECT id FROM times WHERE uuid = ?)", *param.TimeID)
+	}
+	if param.BookingReference != nil {
+		query = query.Where("field_schedules.booking_reference = ?", *param.BookingReference)
+	}
+	if param.CustomerUUID != nil {
+		query = query.Where("field_schedules.customer_uuid = ?", *param.CustomerUUID)
+	}
+	return query
+}
+
 func (f *FieldScheduleRepository) FindAllByFieldIDAndDate(
 	ctx context.Context,
 	fieldID int,
@@ -97,13 +251,90 @@ func (f *FieldScheduleRepository) FindAllByFieldIDAndDate(
 	return fieldSchedules, nil
 }
 
+// FindAllAvailable returns the available slots of every field matching the search, ordered by field, date and
+// start time. Expired holds count as available unless the date has been closed since. The dates and times in the
+// param are expected to be normalized by the caller.
+func (f *FieldScheduleRepository) FindAllAvailable(
+	ctx context.Context,
+	param *dto.FieldAvailabilityRequestParam,
+) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	query := f.db.
+		WithContext(ctx).
+		Preload("Field.Venue").
+		Preload("Time").
+		Joins("JOIN fields ON fields.id = field_schedules.field_id AND fields.deleted_at IS NULL").
+		Joins("JOIN times ON times.id = field_schedules.time_id").
+		Where(
+			"(field_schedules.status = ? OR (field_schedules.status = ? AND field_schedules.hold_expires_at <= ? AND NOT "+
+				closedScheduleCondition+"))",
+			constants.Available, constants.Held, time.Now()).
+		Where("field_schedules.date BETWEEN ? AND ?", param.StartDate, param.EndDate)
+	if param.StartTime != "" {
+		query = query.Where("times.start_time >= ?", param.StartTime)
+	}
+	if param.EndTime != "" {
+		query = query.Where("times.crosses_midnight = ? AND times.end_time <= ?", false, param.EndTime)
+	}
+	if param.SportType != "" {
+		query = query.Where("fields.sport_type = ?", param.SportType)
+	}
+	if param.MinPrice != nil {
+		query = query.Where("COALESCE(field_schedules.price, fields.price_per_hour) >= ?", *param.MinPrice)
+	}
+	if param.MaxPrice != nil {
+		query = query.Where("COALESCE(field_schedules.price, fields.price_per_hour) <= ?", *param.MaxPrice)
+	}
+	if param.VenueID != "" {
+		query = query.Where("fields.venue_id = (SELECT id FROM venues WHERE uuid = ?)", param.VenueID)
+	}
+
+	err := query.
+		Order("fields.name asc").
+		Order("fields.id asc").
+		Order("field_schedules.date asc").
+		Order("times.start_time asc").
+		Find(&fieldSchedules).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return fieldSchedules, nil
+}
+
 func (f *FieldScheduleRepository) FindByUUID(ctx context.Context, uuid string) (*models.FieldSchedule, error) {
 	var fieldSchedule models.FieldSchedule
 	err := f.db.
 		WithContext(ctx).
-		Preload("Field").
+		Preload("Field.Venue").
+		Preload("Time").
+		Where("uuid = ?", uuid).
+		First(&fieldSchedule).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errFieldSchedule.ErrFieldScheduleNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &fieldSchedule, nil
+}
+
+// FindDeletedByUUIDForUpdate loads a soft deleted schedule with its field and time and locks it for the restore.
+func (f *FieldScheduleRepository) FindDeletedByUUIDForUpdate(
+	ctx context.Context,
+	tx *gorm.DB,
+	uuid string,
+) (*models.FieldSchedule, error) {
+	var fieldSchedule models.FieldSchedule
+	err := tx.
+		WithContext(ctx).
+		Unscoped().
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Preload("Field.Venue").
 		Preload("Time").
 		Where("uuid = ?", uuid).
+		Where("deleted_at IS NOT NULL").
 		First(&fieldSchedule).
 		Error
 	if err != nil {
@@ -115,13 +346,54 @@ func (f *FieldScheduleRepository) FindByUUID(ctx context.Context, uuid string) (
 	return &fieldSchedule, nil
 }
 
+func (f *FieldScheduleRepository) FindAllByUUIDsForUpdate(
+	ctx context.Context,
+	tx *gorm.DB,
+	uuids []string,
+) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	err := tx.
+		WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("uuid IN ?", uuids).
+		Order("id asc").
+		Find(&fieldSchedules).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return fieldSchedules, nil
+}
+
+// FindAllByIDs loads the schedules with their field and time, for checks on schedules already locked in tx.
+func (f *FieldScheduleRepository) FindAllByIDs(
+	ctx context.Context,
+	tx *gorm.DB,
+	ids []uint,
+) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	err := tx.
+		WithContext(ctx).
+		Preload("Field.Venue").
+		Preload("Time").
+		Where("id IN ?", ids).
+		Order("id asc").
+		Find(&fieldSchedules).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return fieldSchedules, nil
+}
+
 func (f *FieldScheduleRepository) FindByDateAndTimeID(
 	ctx context.Context,
+	tx *gorm.DB,
 	date string,
 	timeID int,
 	fieldID int) (*models.FieldSchedule, error) {
 	var fieldSchedule models.FieldSchedule
-	err := f.db.
+	err := tx.
 		WithContext(ctx).
 		Where("date = ?", date).
 		Where("time_id = ?", timeID).
@@ -137,52 +409,368 @@ func (f *FieldScheduleRepository) FindByDateAndTimeID(
 	return &fieldSchedule, nil
 }
 
-func (f *FieldScheduleRepository) Create(ctx context.Context, req []models.FieldSchedule) error {
-	err := f.db.WithContext(ctx).Create(&req).Error
+// Create inserts the schedules and silently skips slots that already exist, returning the rows that were created.
+// The created rows are read back by their new UUIDs: with skipped rows the IDs the insert returns do not line up
+// with the schedules they belong to.
+func (f *FieldScheduleRepository) Create(
+	ctx context.Context,
+	tx *gorm.DB,
+	req []models.FieldSchedule,
+) ([]models.FieldSchedule, error) {
+	if len(req) == 0 {
+		return nil, nil
+	}
+
+	err := tx.
+		WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		CreateInBatches(&req, createBatchSize).
+		Error
 	if err != nil {
-		return errWrap.WrapError(errConstant.ErrSQLError)
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
-	return nil
+
+	created := make([]models.FieldSchedule, 0, len(req))
+	for start := 0; start < len(req); start += createBatchSize {
+		end := min(start+createBatchSize, len(req))
+		uuids := make([]uuid.UUID, 0, end-start)
+		for _, fieldSchedule := range req[start:end] {
+			uuids = append(uuids, fieldSchedule.UUID)
+		}
+
+		var batch []models.FieldSchedule
+		err = tx.WithContext(ctx).Where("uuid IN ?", uuids).Order("id asc").Find(&batch).Error
+		if err != nil {
+			return nil, errWrap.WrapError(errConstant.ErrSQLError)
+		}
+		created = append(created, batch...)
+	}
+	return created, nil
 }
 
+// Update moves the schedule to the date, time and price of req within tx and returns it as persisted. It fails
+// with ErrFieldScheduleIsExist when another schedule already takes the slot.
 func (f *FieldScheduleRepository) Update(
 	ctx context.Context,
+	tx *gorm.DB,
 	uuid string,
 	req *models.FieldSchedule,
 ) (*models.FieldSchedule, error) {
-	fieldSchedule, err := f.FindByUUID(ctx, uuid)
-	if err != nil {
-		return nil, err
+	fieldSchedule := models.FieldSchedule{
+		Date:   req.Date,
+		TimeID: req.TimeID,
+		Price:  req.Price,
+	}
+	result := tx.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Where("uuid = ?", uuid).
+		Select("date", "time_id", "price", "updated_at").
+		Updates(&fieldSchedule)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, errWrap.WrapError(errFieldSchedule.ErrFieldScheduleIsExist)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	if result.RowsAffected == 0 {
+		return nil, errWrap.WrapError(errFieldSchedule.ErrFieldScheduleNotFound)
 	}
 
-	fieldSchedule.Date = req.Date
-	err = f.db.WithContext(ctx).Save(&fieldSchedule).Error
+	var updated models.FieldSchedule
+	err := tx.
+		WithContext(ctx).
+		Preload("Field.Venue").
+		Preload("Time").
+		Where("uuid = ?", uuid).
+		First(&updated).
+		Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
-	return fieldSchedule, nil
+	return &updated, nil
+}
+
+func (f *FieldScheduleRepository) UpdatePrice(ctx context.Context, uuid string, price int) error {
+	err := f.db.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Where("uuid = ?", uuid).
+		Update("price", price).
+		Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
 }
 
-func (f *FieldScheduleRepository) UpdateStatus(
+// UpdateStatusByIDs moves the schedules to the status. A schedule that would become available on a closed date is
+// blocked instead. The booking columns are cleared when the slot becomes available; otherwise the values in
+// booking are written, and a column left nil keeps the value of a hold that has not expired so confirming a hold
+// keeps its reference. The booked at time is set when the slot is booked.
+func (f *FieldScheduleRepository) UpdateStatusByIDs(
 	ctx context.Context,
+	tx *gorm.DB,
+	ids []uint,
 	status constants.FieldScheduleStatus,
-	uuid string,
+	holdExpiresAt *time.Time,
+	booking *models.Booking,
 ) error {
-	fieldSchedule, err := f.FindByUUID(ctx, uuid)
+	now := time.Now()
+	updates := map[string]interface{}{
+		"status":            status,
+		"hold_expires_at":   holdExpiresAt,
+		"booking_reference": nil,
+		"customer_uuid":     nil,
+		"booked_at":         nil,
+		"updated_at":        now,
+	}
+	if status == constants.Available {
+		updates["status"] = gorm.Expr(
+			"CASE WHEN "+closedScheduleCondition+" THEN ? ELSE ? END", constants.Blocked, constants.Available)
+	} else {
+		if booking == nil {
+			booking = &models.Booking{}
+		}
+		updates["booking_reference"] = gorm.Expr(
+			"COALESCE(?, CASE WHEN status = ? AND hold_expires_at > ? THEN booking_reference END)",
+			booking.BookingReference, constants.Held, now)
+		updates["customer_uuid"] = gorm.Expr(
+			"COALESCE(?, CASE WHEN status = ? AND hold_expires_at > ? THEN customer_uuid END)",
+			booking.CustomerUUID, constants.Held, now)
+	}
+	if status == constants.Booked {
+		updates["booked_at"] = now
+	}
+
+	err := tx.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Where("id IN ?", ids).
+		Updates(updates).
+		Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// ReleaseExpiredHolds makes the expired holds available again, or blocked on a closed date, and records each
+// release in the history with the booking the hold carried, all in one statement.
+func (f *FieldScheduleRepository) ReleaseExpiredHolds(ctx context.Context) (int64, error) {
+	now := time.Now()
+	result := f.db.
+		WithContext(ctx).
+		Exec(`WITH released AS (
+			UPDATE field_schedules
+			SET status = CASE WHEN `+closedScheduleCondition+` THEN ? ELSE ? END,
+				hold_expires_at = NULL, booking_reference = NULL, customer_uuid = NULL, booked_at = NULL, updated_at = ?
+			FROM (
+				SELECT id, booking_reference, customer_uuid
+				FROM field_schedules
+				WHERE status = ? AND hold_expires_at <= ? AND deleted_at IS NULL
+				FOR UPDATE
+			) expired
+			WHERE field_schedules.id = expired.id
+			RETURNING field_schedules.id, field_schedules.status, expired.booking_reference, expired.customer_uuid
+		)
+		INSERT INTO field_schedule_histories (
+			uuid, field_schedule_id, from_status, to_status, reason, booking_reference, customer_uuid, created_at
+		)
+		SELECT gen_random_uuid(), id, ?, status, ?, booking_reference, customer_uuid, ?
+		FROM released`,
+			constants.Blocked, constants.Available, now,
+			constants.Held, now,
+			constants.Held, constants.ChangeExpire, now,
+		)
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return result.RowsAffected, nil
+}
+
+func (f *FieldScheduleRepository) CreateHistories(
+	ctx context.Context,
+	tx *gorm.DB,
+	histories []models.FieldScheduleHistory,
+) error {
+	if len(histories) == 0 {
+		return nil
+	}
+
+	for i := range histories {
+		histories[i].UUID = uuid.New()
+	}
+	err := tx.WithContext(ctx).Create(&histories).Error
 	if err != nil {
-		return err
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+// FindAllHistoriesByFieldScheduleID returns the transitions of the schedule, oldest first.
+func (f *FieldScheduleRepository) FindAllHistoriesByFieldScheduleID(
+	ctx context.Context,
+	fieldScheduleID uint,
+) ([]models.FieldScheduleHistory, error) {
+	var histories []models.FieldScheduleHistory
+	err := f.db.
+		WithContext(ctx).
+		Where("field_schedule_id = ?", fieldScheduleID).
+		Order("created_at asc").
+		Order("id asc").
+		Find(&histories).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return histories, nil
+}
+
+// FindAllTakenByDateRange returns the held and booked slots in the date range, for every field when fieldIDs
+// is nil.
+func (f *FieldScheduleRepository) FindAllTakenByDateRange(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldIDs []uint,
+	startDate time.Time,
+	endDate time.Time,
+) ([]models.FieldSchedule, error) {
+	var fieldSchedules []models.FieldSchedule
+	query := tx.
+		WithContext(ctx).
+		Preload("Field").
+		Preload("Time").
+		Where("date BETWEEN ? AND ?", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)).
+		Where("status IN ?", []constants.FieldScheduleStatus{constants.Held, constants.Booked})
+	if fieldIDs != nil {
+		query = query.Where("field_id IN ?", fieldIDs)
+	}
+
+	err := query.Order("date asc").Find(&fieldSchedules).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return fieldSchedules, nil
+}
+
+// UpdateStatusByDateRange moves every slot in the date range from one status to another, for every field
+// when fieldIDs is nil.
+func (f *FieldScheduleRepository) UpdateStatusByDateRange(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldIDs []uint,
+	startDate time.Time,
+	endDate time.Time,
+	from constants.FieldScheduleStatus,
+	to constants.FieldScheduleStatus,
+) (int64, error) {
+	query := tx.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Where("date BETWEEN ? AND ?", startDate.Format(time.DateOnly), endDate.Format(time.DateOnly)).
+		Where("status = ?", from)
+	if fieldIDs != nil {
+		query = query.Where("field_id IN ?", fieldIDs)
+	}
+
+	result := query.Updates(map[string]interface{}{
+		"status":     to,
+		"updated_at": time.Now(),
+	})
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
 	}
+	return result.RowsAffected, nil
+}
 
-	fieldSchedule.Status = status
-	err = f.db.WithContext(ctx).Save(&fieldSchedule).Error
+// CountTakenByTimeID counts the held and booked slots using the time. When defaultLoc is set only slots from
+// today on are counted, today being taken in each field's timezone and defaultLoc used where none is set.
+func (f *FieldScheduleRepository) CountTakenByTimeID(
+	ctx context.Context,
+	tx *gorm.DB,
+	timeID uint,
+	defaultLoc *time.Location,
+) (int64, error) {
+	var total int64
+	query := tx.
+		WithContext(ctx).
+		Model(&models.FieldSchedule{}).
+		Where("time_id = ?", timeID).
+		Where("status IN ?", []constants.FieldScheduleStatus{constants.Held, constants.Booked})
+	if defaultLoc != nil {
+		query = query.Where("date >= "+fieldTodayQuery, defaultLoc.String())
+	}
+
+	err := query.Count(&total).Error
 	if err != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return total, nil
+}
+
+// DeleteUnbookedByTimeID removes the available and blocked slots using the time. When defaultLoc is set only
+// slots from today on are removed, today being taken in each field's timezone and defaultLoc used where none is set.
+func (f *FieldScheduleRepository) DeleteUnbookedByTimeID(
+	ctx context.Context,
+	tx *gorm.DB,
+	timeID uint,
+	defaultLoc *time.Location,
+) (int64, error) {
+	query := tx.
+		WithContext(ctx).
+		Where("time_id = ?", timeID).
+		Where("status IN ?", []constants.FieldScheduleStatus{constants.Available, constants.Blocked})
+	if defaultLoc != nil {
+		query = query.Where("date >= "+fieldTodayQuery, defaultLoc.String())
+	}
+
+	result := query.Delete(&models.FieldSchedule{})
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return result.RowsAffected, nil
+}
+
+// DeletePastUnbooked permanently removes unbooked schedules dated before today in their field's timezone,
+// including soft deleted ones, since nothing can be restored into the past. Schedules that went through a status
+// change are kept so their history survives. defaultLoc is used for fields and venues without a timezone.
+func (f *FieldScheduleRepository) DeletePastUnbooked(ctx context.Context, defaultLoc *time.Location) (int64, error) {
+	result := f.db.
+		WithContext(ctx).
+		Unscoped().
+		Where("date < "+fieldTodayQuery, defaultLoc.String()).
+		Where("status <> ?", constants.Booked).
+		Where("NOT EXISTS (SELECT 1 FROM field_schedule_histories " +
+			"WHERE field_schedule_histories.field_schedule_id = field_schedules.id)").
+		Delete(&models.FieldSchedule{})
+	if result.Error != nil {
+		return 0, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return result.RowsAffected, nil
+}
+
+// Restore brings a soft deleted schedule back. It fails with ErrFieldScheduleIsExist when its slot has been taken
+// by another schedule in the meantime.
+func (f *FieldScheduleRepository) Restore(ctx context.Context, tx *gorm.DB, uuid string) error {
+	err := tx.
+		WithContext(ctx).
+		Unscoped().
+		Model(&models.FieldSchedule{}).
+		Where("uuid = ?", uuid).
+		Update("deleted_at", nil).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return errWrap.WrapError(errFieldSchedule.ErrFieldScheduleIsExist)
+		}
 		return errWrap.WrapError(errConstant.ErrSQLError)
 	}
 	return nil
 }
 
-func (f *FieldScheduleRepository) Delete(ctx context.Context, uuid string) error {
-	err := f.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.FieldSchedule{}).Error
+func (f *FieldScheduleRepository) Delete(ctx context.Context, tx *gorm.DB, uuid string) error {
+	err := tx.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.FieldSchedule{}).Error
 	if err != nil {
 		return errWrap.WrapError(errConstant.ErrSQLError)
 	}
@@ -0,0 +1,39 @@
+package repositories
+
+import (
+	"context"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+
+	"gorm.io/gorm"
+)
+
+type LockRepository struct {
+	db *gorm.DB
+}
+
+type ILockRepository interface {
+	WithAdvisoryLock(context.Context, int64, func(context.Context) error) (bool, error)
+}
+
+func NewLockRepository(db *gorm.DB) ILockRepository {
+	return &LockRepository{db: db}
+}
+
+// WithAdvisoryLock runs fn only when the transaction-scoped Postgres advisory lock for key is free,
+// so that several replicas never run the same job at once. It reports whether the lock was acquired.
+func (l *LockRepository) WithAdvisoryLock(ctx context.Context, key int64, fn func(context.Context) error) (bool, error) {
+	var acquired bool
+	err := l.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Raw("SELECT pg_try_advisory_xact_lock(?)", key).Scan(&acquired).Error
+		if err != nil {
+			return errWrap.WrapError(errConstant.ErrSQLError)
+		}
+
+		if !acquired {
+			return nil
+		}
+		return fn(ctx)
+	})
+	return acquired, err
+}
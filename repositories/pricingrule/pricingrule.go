@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errPricingRule "field-service/constants/error/pricingrule"
+	"field-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type PricingRuleRepository struct {
+	db *gorm.DB
+}
+
+type IPricingRuleRepository interface {
+	FindAllByFieldID(context.Context, uint) ([]models.PricingRule, error)
+	FindByUUID(context.Context, string) (*models.PricingRule, error)
+	Create(context.Context, *models.PricingRule) (*models.PricingRule, error)
+	Update(context.Context, string, *models.PricingRule) (*models.PricingRule, error)
+	Delete(context.Context, string) error
+}
+
+func NewPricingRuleRepository(db *gorm.DB) IPricingRuleRepository {
+	return &PricingRuleRepository{db: db}
+}
+
+func (p *PricingRuleRepository) FindAllByFieldID(ctx context.Context, fieldID uint) ([]models.PricingRule, error) {
+	var pricingRules []models.PricingRule
+	err := p.db.
+		WithContext(ctx).
+		Preload("Field").
+		Preload("Time").
+		Where("field_id = ?", fieldID).
+		Order("created_at asc").
+		Find(&pricingRules).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return pricingRules, nil
+}
+
+func (p *PricingRuleRepository) FindByUUID(ctx context.Context, uuid string) (*models.PricingRule, error) {
+	var pricingRule models.PricingRule
+	err := p.db.
+		WithContext(ctx).
+		Preload("Field").
+		Preload("Time").
+		Where("uuid = ?", uuid).
+		First(&pricingRule).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errPricingRule.ErrPricingRuleNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &pricingRule, nil
+}
+
+func (p *PricingRuleRepository) Create(ctx context.Context, req *models.PricingRule) (*models.PricingRule, error) {
+	pricingRule := models.PricingRule{
+		UUID:             uuid.New(),
+		FieldID:          req.FieldID,
+		Name:             req.Name,
+		Type:             req.Type,
+		TimeID:           req.TimeID,
+		Date:             req.Date,
+		Price:            req.Price,
+		SurchargePercent: req.SurchargePercent,
+	}
+
+	err := p.db.WithContext(ctx).Create(&pricingRule).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &pricingRule, nil
+}
+
+func (p *PricingRuleRepository) Update(
+	ctx context.Context,
+	uuid string,
+	req *models.PricingRule,
+) (*models.PricingRule, error) {
+	pricingRule, err := p.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRule.Name = req.Name
+	pricingRule.Type = req.Type
+	pricingRule.TimeID = req.TimeID
+	pricingRule.Date = req.Date
+	pricingRule.Price = req.Price
+	pricingRule.SurchargePercent = req.SurchargePercent
+	err = p.db.WithContext(ctx).Omit("Field", "Time").Save(pricingRule).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return pricingRule, nil
+}
+
+func (p *PricingRuleRepository) Delete(ctx context.Context, uuid string) error {
+	err := p.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.PricingRule{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
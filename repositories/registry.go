@@ -1,9 +1,15 @@
 package repositories
 
 import (
+	auditLogRepo "field-service/repositories/auditlog"
+	closureRepo "field-service/repositories/closure"
 	fieldRepo "field-service/repositories/field"
 	fieldScheduleRepo "field-service/repositories/fieldschedule"
+	lockRepo "field-service/repositories/lock"
+	pricingRuleRepo "field-service/repositories/pricingrule"
+	scheduleTemplateRepo "field-service/repositories/scheduletemplate"
 	timeRepo "field-service/repositories/time"
+	venueRepo "field-service/repositories/venue"
 
 	"gorm.io/gorm"
 )
@@ -16,6 +22,13 @@ type IRepositoryRegistry interface {
 	GetField() fieldRepo.IFieldRepository
 	GetFieldSchedule() fieldScheduleRepo.IFieldScheduleRepository
 	GetTime() timeRepo.ITimeRepository
+	GetScheduleTemplate() scheduleTemplateRepo.IScheduleTemplateRepository
+	GetPricingRule() pricingRuleRepo.IPricingRuleRepository
+	GetClosure() closureRepo.IClosureRepository
+	GetVenue() venueRepo.IVenueRepository
+	GetLock() lockRepo.ILockRepository
+	GetAuditLog() auditLogRepo.IAuditLogRepository
+	GetTx() *gorm.DB
 }
 
 func NewRepositoryRegistry(db *gorm.DB) IRepositoryRegistry {
@@ -33,3 +46,31 @@ func (r *Registry) GetFieldSchedule() fieldScheduleRepo.IFieldScheduleRepository
 func (r *Registry) GetTime() timeRepo.ITimeRepository {
 	return timeRepo.NewTimeRepository(r.db)
 }
+
+func (r *Registry) GetScheduleTemplate() scheduleTemplateRepo.IScheduleTemplateRepository {
+	return scheduleTemplateRepo.NewScheduleTemplateRepository(r.db)
+}
+
+func (r *Registry) GetPricingRule() pricingRuleRepo.IPricingRuleRepository {
+	return pricingRuleRepo.NewPricingRuleRepository(r.db)
+}
+
+func (r *Registry) GetClosure() closureRepo.IClosureRepository {
+	return closureRepo.NewClosureRepository(r.db)
+}
+
+func (r *Registry) GetVenue() venueRepo.IVenueRepository {
+	return venueRepo.NewVenueRepository(r.db)
+}
+
+func (r *Registry) GetLock() lockRepo.ILockRepository {
+	return lockRepo.NewLockRepository(r.db)
+}
+
+func (r *Registry) GetAuditLog() auditLogRepo.IAuditLogRepository {
+	return auditLogRepo.NewAuditLogRepository(r.db)
+}
+
+func (r *Registry) GetTx() *gorm.DB {
+	return r.db
+}
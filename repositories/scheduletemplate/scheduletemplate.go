@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errScheduleTemplate "field-service/constants/error/scheduletemplate"
+	"field-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type ScheduleTemplateRepository struct {
+	db *gorm.DB
+}
+
+type IScheduleTemplateRepository interface {
+	FindAllByFieldID(context.Context, uint) ([]models.ScheduleTemplate, error)
+	FindActiveByFieldID(context.Context, uint) ([]models.ScheduleTemplate, error)
+	FindByUUID(context.Context, string) (*models.ScheduleTemplate, error)
+	Create(context.Context, *models.ScheduleTemplate) (*models.ScheduleTemplate, error)
+	Update(context.Context, string, *models.ScheduleTemplate) (*models.ScheduleTemplate, error)
+	Delete(context.Context, string) error
+}
+
+func NewScheduleTemplateRepository(db *gorm.DB) IScheduleTemplateRepository {
+	return &ScheduleTemplateRepository{db: db}
+}
+
+func (s *ScheduleTemplateRepository) FindAllByFieldID(ctx context.Context, fieldID uint) ([]models.ScheduleTemplate, error) {
+	var scheduleTemplates []models.ScheduleTemplate
+	err := s.db.
+		WithContext(ctx).
+		Preload("Field").
+		Preload("Times").
+		Where("field_id = ?", fieldID).
+		Order("created_at asc").
+		Find(&scheduleTemplates).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return scheduleTemplates, nil
+}
+
+func (s *ScheduleTemplateRepository) FindActiveByFieldID(ctx context.Context, fieldID uint) ([]models.ScheduleTemplate, error) {
+	var scheduleTemplates []models.ScheduleTemplate
+	err := s.db.
+		WithContext(ctx).
+		Preload("Times").
+		Where("field_id = ?", fieldID).
+		Where("is_active = ?", true).
+		Find(&scheduleTemplates).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return scheduleTemplates, nil
+}
+
+func (s *ScheduleTemplateRepository) FindByUUID(ctx context.Context, uuid string) (*models.ScheduleTemplate, error) {
+	var scheduleTemplate models.ScheduleTemplate
+	err := s.db.
+		WithContext(ctx).
+		Preload("Field").
+		Preload("Times").
+		Where("uuid = ?", uuid).
+		First(&scheduleTemplate).
+		Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errScheduleTemplate.ErrScheduleTemplateNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &scheduleTemplate, nil
+}
+
+func (s *ScheduleTemplateRepository) Create(
+	ctx context.Context,
+	req *models.ScheduleTemplate,
+) (*models.ScheduleTemplate, error) {
+	scheduleTemplate := models.ScheduleTemplate{
+		UUID:     uuid.New(),
+		FieldID:  req.FieldID,
+		Name:     req.Name,
+		Weekdays: req.Weekdays,
+		IsActive: req.IsActive,
+		Times:    req.Times,
+	}
+
+	err := s.db.WithContext(ctx).Create(&scheduleTemplate).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &scheduleTemplate, nil
+}
+
+func (s *ScheduleTemplateRepository) Update(
+	ctx context.Context,
+	uuid string,
+	req *models.ScheduleTemplate,
+) (*models.ScheduleTemplate, error) {
+	scheduleTemplate, err := s.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		scheduleTemplate.Name = req.Name
+		scheduleTemplate.Weekdays = req.Weekdays
+		scheduleTemplate.IsActive = req.IsActive
+		err := tx.Omit("Times", "Field").Save(scheduleTemplate).Error
+		if err != nil {
+			return err
+		}
+		return tx.Model(scheduleTemplate).Association("Times").Replace(req.Times)
+	})
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	scheduleTemplate.Times = req.Times
+	return scheduleTemplate, nil
+}
+
+func (s *ScheduleTemplateRepository) Delete(ctx context.Context, uuid string) error {
+	scheduleTemplate, err := s.FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = s.db.WithContext(ctx).Select("Times").Delete(scheduleTemplate).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
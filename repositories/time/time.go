@@ -19,18 +19,67 @@ type TimeRepository struct {
 
 type ITimeRepository interface {
 	FindAll(context.Context) ([]models.Time, error)
+	FindAllActive(context.Context) ([]models.Time, error)
+	FindAllByFieldID(context.Context, *gorm.DB, uint) ([]models.Time, error)
+	FindAllActiveByFieldID(context.Context, uint) ([]models.Time, error)
 	FindByUUID(context.Context, string) (*models.Time, error)
 	FindByID(context.Context, int) (*models.Time, error)
-	Create(context.Context, *models.Time) (*models.Time, error)
+	Create(context.Context, *gorm.DB, *models.Time) (*models.Time, error)
+	CreateAll(context.Context, *gorm.DB, []models.Time) error
+	Update(context.Context, *gorm.DB, string, *models.Time) (*models.Time, error)
+	Delete(context.Context, *gorm.DB, string) error
 }
 
 func NewTimeRepository(db *gorm.DB) ITimeRepository {
 	return &TimeRepository{db: db}
 }
 
+// FindAll returns the global time presets, leaving out the slots derived for a single field.
 func (t *TimeRepository) FindAll(ctx context.Context) ([]models.Time, error) {
 	var times []models.Time
-	err := t.db.WithContext(ctx).Find(&times).Error
+	err := t.db.WithContext(ctx).Where("field_id IS NULL").Find(&times).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return times, nil
+}
+
+func (t *TimeRepository) FindAllActive(ctx context.Context) ([]models.Time, error) {
+	var times []models.Time
+	err := t.db.
+		WithContext(ctx).
+		Where("field_id IS NULL").
+		Where("is_active = ?", true).
+		Order("start_time asc").
+		Find(&times).
+		Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return times, nil
+}
+
+func (t *TimeRepository) FindAllByFieldID(ctx context.Context, tx *gorm.DB, fieldID uint) ([]models.Time, error) {
+	var times []models.Time
+	err := tx.WithContext(ctx).Where("field_id = ?", fieldID).Order("start_time asc").Find(&times).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+
+	return times, nil
+}
+
+func (t *TimeRepository) FindAllActiveByFieldID(ctx context.Context, fieldID uint) ([]models.Time, error) {
+	var times []models.Time
+	err := t.db.
+		WithContext(ctx).
+		Where("field_id = ?", fieldID).
+		Where("is_active = ?", true).
+		Order("start_time asc").
+		Find(&times).
+		Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
@@ -64,12 +113,52 @@ func (t *TimeRepository) FindByID(ctx context.Context, id int) (*models.Time, er
 	return &time, nil
 }
 
-func (t *TimeRepository) Create(ctx context.Context, time *models.Time) (*models.Time, error) {
+func (t *TimeRepository) Create(ctx context.Context, tx *gorm.DB, time *models.Time) (*models.Time, error) {
 	time.UUID = uuid.New()
 	fmt.Println("time", time)
-	err := t.db.WithContext(ctx).Create(time).Error
+	err := tx.WithContext(ctx).Create(time).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return time, nil
+}
+
+func (t *TimeRepository) CreateAll(ctx context.Context, tx *gorm.DB, times []models.Time) error {
+	if len(times) == 0 {
+		return nil
+	}
+
+	for i := range times {
+		times[i].UUID = uuid.New()
+	}
+	err := tx.WithContext(ctx).Create(&times).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
+
+func (t *TimeRepository) Update(ctx context.Context, tx *gorm.DB, uuid string, req *models.Time) (*models.Time, error) {
+	time, err := t.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	time.StartTime = req.StartTime
+	time.EndTime = req.EndTime
+	time.CrossesMidnight = req.CrossesMidnight
+	time.IsActive = req.IsActive
+	err = tx.WithContext(ctx).Save(time).Error
 	if err != nil {
 		return nil, errWrap.WrapError(errConstant.ErrSQLError)
 	}
 	return time, nil
 }
+
+func (t *TimeRepository) Delete(ctx context.Context, tx *gorm.DB, uuid string) error {
+	err := tx.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Time{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
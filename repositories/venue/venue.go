@@ -0,0 +1,113 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	errWrap "field-service/common/error"
+	errConstant "field-service/constants/error"
+	errVenue "field-service/constants/error/venue"
+	"field-service/domain/models"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+type VenueRepository struct {
+	db *gorm.DB
+}
+
+type IVenueRepository interface {
+	FindAll(context.Context) ([]models.Venue, error)
+	FindAllByOwnerUUID(context.Context, uuid.UUID) ([]models.Venue, error)
+	FindByUUID(context.Context, string) (*models.Venue, error)
+	Create(context.Context, *models.Venue) (*models.Venue, error)
+	Update(context.Context, string, *models.Venue) (*models.Venue, error)
+	Delete(context.Context, string) error
+}
+
+func NewVenueRepository(db *gorm.DB) IVenueRepository {
+	return &VenueRepository{db: db}
+}
+
+func (v *VenueRepository) FindAll(ctx context.Context) ([]models.Venue, error) {
+	var venues []models.Venue
+	err := v.db.WithContext(ctx).Order("name asc").Find(&venues).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return venues, nil
+}
+
+func (v *VenueRepository) FindAllByOwnerUUID(ctx context.Context, ownerUUID uuid.UUID) ([]models.Venue, error) {
+	var venues []models.Venue
+	err := v.db.WithContext(ctx).Where("owner_uuid = ?", ownerUUID).Order("name asc").Find(&venues).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return venues, nil
+}
+
+func (v *VenueRepository) FindByUUID(ctx context.Context, uuid string) (*models.Venue, error) {
+	var venue models.Venue
+	err := v.db.WithContext(ctx).Where("uuid = ?", uuid).First(&venue).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errWrap.WrapError(errVenue.ErrVenueNotFound)
+		}
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &venue, nil
+}
+
+func (v *VenueRepository) Create(ctx context.Context, req *models.Venue) (*models.Venue, error) {
+	venue := models.Venue{
+		UUID:        uuid.New(),
+		OwnerUUID:   req.OwnerUUID,
+		Name:        req.Name,
+		Address:     req.Address,
+		City:        req.City,
+		Latitude:    req.Latitude,
+		Longitude:   req.Longitude,
+		PhoneNumber: req.PhoneNumber,
+		Email:       req.Email,
+		Amenities:   req.Amenities,
+		Timezone:    req.Timezone,
+	}
+
+	err := v.db.WithContext(ctx).Create(&venue).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return &venue, nil
+}
+
+func (v *VenueRepository) Update(ctx context.Context, uuid string, req *models.Venue) (*models.Venue, error) {
+	venue, err := v.FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	venue.OwnerUUID = req.OwnerUUID
+	venue.Name = req.Name
+	venue.Address = req.Address
+	venue.City = req.City
+	venue.Latitude = req.Latitude
+	venue.Longitude = req.Longitude
+	venue.PhoneNumber = req.PhoneNumber
+	venue.Email = req.Email
+	venue.Amenities = req.Amenities
+	venue.Timezone = req.Timezone
+	err = v.db.WithContext(ctx).Save(venue).Error
+	if err != nil {
+		return nil, errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return venue, nil
+}
+
+func (v *VenueRepository) Delete(ctx context.Context, uuid string) error {
+	err := v.db.WithContext(ctx).Where("uuid = ?", uuid).Delete(&models.Venue{}).Error
+	if err != nil {
+		return errWrap.WrapError(errConstant.ErrSQLError)
+	}
+	return nil
+}
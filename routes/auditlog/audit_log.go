@@ -0,0 +1,36 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditLogRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IAuditLogRoute interface {
+	Run()
+}
+
+func NewAuditLogRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IAuditLogRoute {
+	return &AuditLogRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (a *AuditLogRoute) Run() {
+	group := a.group.Group("/audit-log")
+	group.Use(middlewares.Authenticate())
+	group.GET("/pagination", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, a.client), a.controller.GetAuditLog().GetAllWithPagination)
+}
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ClosureRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IClosureRoute interface {
+	Run()
+}
+
+func NewClosureRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IClosureRoute {
+	return &ClosureRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (c *ClosureRoute) Run() {
+	group := c.group.Group("/closure")
+	group.Use(middlewares.Authenticate())
+	group.GET("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, c.client), c.controller.GetClosure().GetAll)
+	group.GET("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, c.client), c.controller.GetClosure().GetByUUID)
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, c.client), c.controller.GetClosure().Create)
+	group.PUT("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, c.client), c.controller.GetClosure().Update)
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, c.client), c.controller.GetClosure().Delete)
+}
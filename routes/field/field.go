@@ -35,18 +35,22 @@ func (f *FieldRoute) Run() {
 	group.Use(middlewares.Authenticate())
 	group.GET("/pagination", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 		constants.Customer,
 	}, f.client), f.controller.GetField().GetAllWithPagination)
 	group.POST("", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetField().Create)
 	group.PUT("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetField().Update)
 	group.DELETE("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetField().Delete)
 }
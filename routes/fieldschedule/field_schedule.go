@@ -30,32 +30,64 @@ func NewFieldScheduleRoute(controller controllers.IControllerRegistry, group *gi
 func (f *FieldScheduleRoute) Run() {
 	group := f.group.Group("/field/schedule")
 	group.GET("/lists/:uuid", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().GetAllByFieldIDAndDate)
+	group.GET("/availability", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().SearchAvailability)
 	group.PATCH("/status", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().UpdateStatus)
+	group.PATCH("/hold", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Hold)
+	group.PATCH("/confirm", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Confirm)
+	group.PATCH("/release", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Release)
+	group.PATCH("/cancel", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Cancel)
+	group.PATCH("/reschedule", middlewares.AuthenticateWithoutToken(), f.controller.GetFieldSchedule().Reschedule)
 	group.Use(middlewares.Authenticate())
 	group.GET("/pagination", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 		constants.Customer,
 	}, f.client),
 		f.controller.GetFieldSchedule().GetAllWithPagination)
 	group.GET("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 		constants.Customer,
 	}, f.client),
 		f.controller.GetFieldSchedule().GetByUUID)
+	group.GET("/:uuid/history", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, f.client),
+		f.controller.GetFieldSchedule().GetHistory)
 	group.POST("", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetFieldSchedule().Create)
 	group.POST("/one-month", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetFieldSchedule().GenerateScheduleForOneMonth)
+	group.POST("/generate", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, f.client),
+		f.controller.GetFieldSchedule().GenerateSchedule)
 	group.PUT("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetFieldSchedule().Update)
+	group.PATCH("/:uuid/price", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, f.client),
+		f.controller.GetFieldSchedule().UpdatePrice)
+	group.PATCH("/:uuid/restore", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, f.client),
+		f.controller.GetFieldSchedule().Restore)
 	group.DELETE("/:uuid", middlewares.CheckRole([]string{
 		constants.Admin,
+		constants.VenueOwner,
 	}, f.client),
 		f.controller.GetFieldSchedule().Delete)
 }
@@ -0,0 +1,57 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PricingRuleRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IPricingRuleRoute interface {
+	Run()
+}
+
+func NewPricingRuleRoute(
+	controller controllers.IControllerRegistry,
+	group *gin.RouterGroup,
+	client clients.IClientRegistry,
+) IPricingRuleRoute {
+	return &PricingRuleRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (p *PricingRuleRoute) Run() {
+	group := p.group.Group("/field/:uuid/pricing-rules")
+	group.Use(middlewares.Authenticate())
+	group.GET("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, p.client), p.controller.GetPricingRule().GetAll)
+	group.GET("/:ruleUUID", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, p.client), p.controller.GetPricingRule().GetByUUID)
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, p.client), p.controller.GetPricingRule().Create)
+	group.PUT("/:ruleUUID", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, p.client), p.controller.GetPricingRule().Update)
+	group.DELETE("/:ruleUUID", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, p.client), p.controller.GetPricingRule().Delete)
+}
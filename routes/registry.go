@@ -3,9 +3,14 @@ package routes
 import (
 	"field-service/clients"
 	"field-service/controllers"
+	auditLogRoute "field-service/routes/auditlog"
+	closureRoute "field-service/routes/closure"
 	fieldRoute "field-service/routes/field"
 	fieldScheduleRoute "field-service/routes/fieldschedule"
+	pricingRuleRoute "field-service/routes/pricingrule"
+	scheduleTemplateRoute "field-service/routes/scheduletemplate"
 	timeRoute "field-service/routes/time"
+	venueRoute "field-service/routes/venue"
 
 	"github.com/gin-gonic/gin"
 )
@@ -40,8 +45,33 @@ func (r *Registry) timeRoute() timeRoute.ITimeRoute {
 	return timeRoute.NewTimeRoute(r.controller, r.group, r.client)
 }
 
+func (r *Registry) scheduleTemplateRoute() scheduleTemplateRoute.IScheduleTemplateRoute {
+	return scheduleTemplateRoute.NewScheduleTemplateRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) pricingRuleRoute() pricingRuleRoute.IPricingRuleRoute {
+	return pricingRuleRoute.NewPricingRuleRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) closureRoute() closureRoute.IClosureRoute {
+	return closureRoute.NewClosureRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) venueRoute() venueRoute.IVenueRoute {
+	return venueRoute.NewVenueRoute(r.controller, r.group, r.client)
+}
+
+func (r *Registry) auditLogRoute() auditLogRoute.IAuditLogRoute {
+	return auditLogRoute.NewAuditLogRoute(r.controller, r.group, r.client)
+}
+
 func (r *Registry) Serve() {
 	r.fieldRoute().Run()
 	r.fieldScheduleRoute().Run()
 	r.timeRoute().Run()
+	r.scheduleTemplateRoute().Run()
+	r.pricingRuleRoute().Run()
+	r.closureRoute().Run()
+	r.venueRoute().Run()
+	r.auditLogRoute().Run()
 }
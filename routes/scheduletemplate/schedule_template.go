@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ScheduleTemplateRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IScheduleTemplateRoute interface {
+	Run()
+}
+
+func NewScheduleTemplateRoute(
+	controller controllers.IControllerRegistry,
+	group *gin.RouterGroup,
+	client clients.IClientRegistry,
+) IScheduleTemplateRoute {
+	return &ScheduleTemplateRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (s *ScheduleTemplateRoute) Run() {
+	group := s.group.Group("/field/:uuid/templates")
+	group.Use(middlewares.Authenticate())
+	group.GET("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, s.client), s.controller.GetScheduleTemplate().GetAll)
+	group.GET("/:templateUUID", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, s.client), s.controller.GetScheduleTemplate().GetByUUID)
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, s.client), s.controller.GetScheduleTemplate().Create)
+	group.POST("/materialize", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, s.client), s.controller.GetScheduleTemplate().Materialize)
+	group.PUT("/:templateUUID", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, s.client), s.controller.GetScheduleTemplate().Update)
+	group.DELETE("/:templateUUID", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, s.client), s.controller.GetScheduleTemplate().Delete)
+}
@@ -39,4 +39,10 @@ func (t *TimeRoute) Run() {
 	group.POST("", middlewares.CheckRole([]string{
 		constants.Admin,
 	}, t.client), t.controller.GetTime().Create)
+	group.PUT("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, t.client), t.controller.GetTime().Update)
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, t.client), t.controller.GetTime().Delete)
 }
@@ -0,0 +1,53 @@
+package routes
+
+import (
+	"field-service/clients"
+	"field-service/constants"
+	"field-service/controllers"
+	"field-service/middlewares"
+
+	"github.com/gin-gonic/gin"
+)
+
+type VenueRoute struct {
+	controller controllers.IControllerRegistry
+	group      *gin.RouterGroup
+	client     clients.IClientRegistry
+}
+
+type IVenueRoute interface {
+	Run()
+}
+
+func NewVenueRoute(controller controllers.IControllerRegistry, group *gin.RouterGroup, client clients.IClientRegistry) IVenueRoute {
+	return &VenueRoute{
+		controller: controller,
+		group:      group,
+		client:     client,
+	}
+}
+
+func (v *VenueRoute) Run() {
+	group := v.group.Group("/venue")
+	group.Use(middlewares.Authenticate())
+	group.GET("", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+		constants.Customer,
+	}, v.client), v.controller.GetVenue().GetAll)
+	group.GET("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+		constants.Customer,
+	}, v.client), v.controller.GetVenue().GetByUUID)
+	group.POST("", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client), v.controller.GetVenue().Create)
+	group.PUT("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+		constants.VenueOwner,
+	}, v.client), v.controller.GetVenue().Update)
+	group.DELETE("/:uuid", middlewares.CheckRole([]string{
+		constants.Admin,
+	}, v.client), v.controller.GetVenue().Delete)
+}
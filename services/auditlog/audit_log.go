@@ -0,0 +1,57 @@
+package services
+
+import (
+	"context"
+	"field-service/common/util"
+	"field-service/domain/dto"
+	"field-service/repositories"
+)
+
+type AuditLogService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IAuditLogService interface {
+	GetAllWithPagination(context.Context, *dto.AuditLogRequestParam) (*util.PaginationResult, error)
+}
+
+func NewAuditLogService(repository repositories.IRepositoryRegistry) IAuditLogService {
+	return &AuditLogService{repository: repository}
+}
+
+// GetAllWithPagination implements IAuditLogService.
+func (a *AuditLogService) GetAllWithPagination(
+	ctx context.Context,
+	param *dto.AuditLogRequestParam,
+) (*util.PaginationResult, error) {
+	auditLogs, total, err := a.repository.GetAuditLog().FindAllWithPagination(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	auditLogResults := make([]dto.AuditLogResponse, 0, len(auditLogs))
+	for _, auditLog := range auditLogs {
+		auditLogResults = append(auditLogResults, dto.AuditLogResponse{
+			UUID:        auditLog.UUID,
+			EntityType:  auditLog.EntityType,
+			EntityUUID:  auditLog.EntityUUID,
+			Action:      auditLog.Action,
+			ActorUUID:   auditLog.ActorUUID,
+			ActorName:   auditLog.ActorName,
+			ActorRole:   auditLog.ActorRole,
+			ServiceName: auditLog.ServiceName,
+			RequestID:   auditLog.RequestID,
+			Before:      auditLog.Before,
+			After:       auditLog.After,
+			CreatedAt:   auditLog.CreatedAt,
+		})
+	}
+
+	pagination := util.GeneratePagination(util.PaginationParam{
+		Count: total,
+		Page:  param.Page,
+		Limit: param.Limit,
+		Data:  auditLogResults,
+	})
+	return &pagination, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"field-service/constants"
+	"field-service/domain/models"
+	"field-service/repositories"
+	venueService "field-service/services/venue"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// Entry describes one mutation to record. Before and After are the entity as loaded from the database; only its
+// own columns are kept, loaded associations are dropped.
+type Entry struct {
+	EntityType constants.AuditEntity
+	EntityUUID uuid.UUID
+	Action     constants.AuditAction
+	Before     any
+	After      any
+}
+
+// Record writes the entries together with the actor and request ID found in ctx. Inside a transaction the error
+// is returned so the mutation rolls back with it; with a nil tx the mutation is already committed, so a failed
+// write is only logged.
+func Record(ctx context.Context, repository repositories.IRepositoryRegistry, tx *gorm.DB, entries ...Entry) error {
+	auditLogs := make([]models.AuditLog, 0, len(entries))
+	for _, entry := range entries {
+		auditLogs = append(auditLogs, newAuditLog(ctx, entry))
+	}
+
+	if tx != nil {
+		return repository.GetAuditLog().Create(ctx, tx, auditLogs)
+	}
+
+	err := repository.GetAuditLog().Create(ctx, repository.GetTx(), auditLogs)
+	if err != nil {
+		logrus.Errorf("failed to record audit log: %v", err)
+	}
+	return nil
+}
+
+func newAuditLog(ctx context.Context, entry Entry) models.AuditLog {
+	auditLog := models.AuditLog{
+		EntityType: entry.EntityType,
+		EntityUUID: entry.EntityUUID,
+		Action:     entry.Action,
+		Before:     snapshot(entry.Before),
+		After:      snapshot(entry.After),
+	}
+
+	if user := venueService.CurrentUser(ctx); user != nil {
+		auditLog.ActorUUID = &user.UUID
+		auditLog.ActorName = &user.Name
+		auditLog.ActorRole = &user.Role
+	}
+	if serviceName, ok := ctx.Value(constants.ServiceName).(string); ok && serviceName != "" {
+		auditLog.ServiceName = &serviceName
+	}
+	if requestID, ok := ctx.Value(constants.RequestID).(string); ok && requestID != "" {
+		auditLog.RequestID = &requestID
+	}
+	return auditLog
+}
+
+// snapshot serializes the entity without its associations, which are recognised as nested objects or lists of
+// objects.
+func snapshot(value any) json.RawMessage {
+	if value == nil {
+		return nil
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+
+	var columns map[string]any
+	if err = json.Unmarshal(payload, &columns); err != nil {
+		return payload
+	}
+	for key, column := range columns {
+		if isAssociation(column) {
+			delete(columns, key)
+		}
+	}
+
+	payload, err = json.Marshal(columns)
+	if err != nil {
+		return nil
+	}
+	return payload
+}
+
+func isAssociation(value any) bool {
+	switch typed := value.(type) {
+	case map[string]any:
+		return true
+	case []any:
+		return len(typed) > 0 && isAssociation(typed[0])
+	}
+	return false
+}
@@ -0,0 +1,389 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errClosure "field-service/constants/error/closure"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	venueService "field-service/services/venue"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ClosureService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IClosureService interface {
+	GetAll(context.Context) ([]dto.ClosureResponse, error)
+	GetByUUID(context.Context, string) (*dto.ClosureResponse, error)
+	Create(context.Context, *dto.ClosureRequest) (*dto.ClosureResponse, error)
+	Update(context.Context, string, *dto.ClosureRequest) (*dto.ClosureResponse, error)
+	Delete(context.Context, string) error
+}
+
+func NewClosureService(repository repositories.IRepositoryRegistry) IClosureService {
+	return &ClosureService{repository: repository}
+}
+
+// GetAll implements IClosureService.
+func (c *ClosureService) GetAll(ctx context.Context) ([]dto.ClosureResponse, error) {
+	closures, err := c.repository.GetClosure().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.ClosureResponse, 0, len(closures))
+	for _, closure := range closures {
+		if c.authorizeClosure(ctx, &closure) != nil {
+			continue
+		}
+		results = append(results, c.toResponse(&closure))
+	}
+	return results, nil
+}
+
+// GetByUUID implements IClosureService.
+func (c *ClosureService) GetByUUID(ctx context.Context, uuid string) (*dto.ClosureResponse, error) {
+	closure, err := c.repository.GetClosure().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.authorizeClosure(ctx, closure)
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.toResponse(closure)
+	return &response, nil
+}
+
+// Create implements IClosureService. Available slots inside the closure are blocked, and slots that are
+// already held or booked are returned so an admin can reschedule them.
+func (c *ClosureService) Create(ctx context.Context, request *dto.ClosureRequest) (*dto.ClosureResponse, error) {
+	req, err := c.resolveClosure(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		closure   *models.Closure
+		blocked   int64
+		conflicts []models.FieldSchedule
+	)
+	err = c.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		closure, txErr = c.repository.GetClosure().Create(ctx, tx, req)
+		if txErr != nil {
+			return txErr
+		}
+
+		blocked, conflicts, txErr = c.applyClosure(ctx, tx, closure)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	closure.Field = req.Field
+	closure.Venue = req.Venue
+	response := c.toResponse(closure)
+	response.BlockedSchedules = blocked
+	response.ConflictingSchedules = c.toScheduleResponses(conflicts)
+	return &response, nil
+}
+
+// Update implements IClosureService.
+func (c *ClosureService) Update(ctx context.Context, uuid string, request *dto.ClosureRequest) (*dto.ClosureResponse, error) {
+	current, err := c.repository.GetClosure().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.authorizeClosure(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.resolveClosure(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		closure   *models.Closure
+		blocked   int64
+		conflicts []models.FieldSchedule
+	)
+	err = c.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := c.liftClosure(ctx, tx, current)
+		if txErr != nil {
+			return txErr
+		}
+
+		closure, txErr = c.repository.GetClosure().Update(ctx, tx, uuid, req)
+		if txErr != nil {
+			return txErr
+		}
+
+		blocked, conflicts, txErr = c.applyClosure(ctx, tx, closure)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := c.toResponse(closure)
+	response.BlockedSchedules = blocked
+	response.ConflictingSchedules = c.toScheduleResponses(conflicts)
+	return &response, nil
+}
+
+// Delete implements IClosureService.
+func (c *ClosureService) Delete(ctx context.Context, uuid string) error {
+	closure, err := c.repository.GetClosure().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = c.authorizeClosure(ctx, closure)
+	if err != nil {
+		return err
+	}
+
+	return c.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := c.repository.GetClosure().Delete(ctx, tx, uuid)
+		if txErr != nil {
+			return txErr
+		}
+		return c.liftClosure(ctx, tx, closure)
+	})
+}
+
+func (c *ClosureService) applyClosure(
+	ctx context.Context,
+	tx *gorm.DB,
+	closure *models.Closure,
+) (int64, []models.FieldSchedule, error) {
+	fieldIDs, err := c.scopeFieldIDs(ctx, tx, closure)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	blocked, err := c.repository.GetFieldSchedule().UpdateStatusByDateRange(
+		ctx, tx, fieldIDs, closure.StartDate, closure.EndDate, constants.Available, constants.Blocked)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	conflicts, err := c.repository.GetFieldSchedule().FindAllTakenByDateRange(
+		ctx, tx, fieldIDs, closure.StartDate, closure.EndDate)
+	if err != nil {
+		return 0, nil, err
+	}
+	return blocked, conflicts, nil
+}
+
+// liftClosure unblocks the closure's range and then blocks it again wherever another closure still applies.
+func (c *ClosureService) liftClosure(ctx context.Context, tx *gorm.DB, closure *models.Closure) error {
+	fieldIDs, err := c.scopeFieldIDs(ctx, tx, closure)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.repository.GetFieldSchedule().UpdateStatusByDateRange(
+		ctx, tx, fieldIDs, closure.StartDate, closure.EndDate, constants.Blocked, constants.Available)
+	if err != nil {
+		return err
+	}
+
+	others, err := c.repository.GetClosure().FindOverlapping(ctx, tx, nil, nil, closure.StartDate, closure.EndDate)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range others {
+		if other.ID == closure.ID {
+			continue
+		}
+
+		otherFieldIDs, err := c.scopeFieldIDs(ctx, tx, &other)
+		if err != nil {
+			return err
+		}
+		sharedFieldIDs, ok := intersectScopes(fieldIDs, otherFieldIDs)
+		if !ok {
+			continue
+		}
+
+		startDate := other.StartDate
+		if closure.StartDate.After(startDate) {
+			startDate = closure.StartDate
+		}
+		endDate := other.EndDate
+		if closure.EndDate.Before(endDate) {
+			endDate = closure.EndDate
+		}
+
+		_, err = c.repository.GetFieldSchedule().UpdateStatusByDateRange(
+			ctx, tx, sharedFieldIDs, startDate, endDate, constants.Available, constants.Blocked)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// scopeFieldIDs returns the fields a closure applies to, or nil when it applies to every field.
+func (c *ClosureService) scopeFieldIDs(ctx context.Context, tx *gorm.DB, closure *models.Closure) ([]uint, error) {
+	if closure.FieldID != nil {
+		return []uint{*closure.FieldID}, nil
+	}
+	if closure.VenueID == nil {
+		return nil, nil
+	}
+
+	fields, err := c.repository.GetField().FindAllByVenueID(ctx, tx, *closure.VenueID)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldIDs := make([]uint, 0, len(fields))
+	for _, field := range fields {
+		fieldIDs = append(fieldIDs, field.ID)
+	}
+	return fieldIDs, nil
+}
+
+// intersectScopes returns the fields two closure scopes share, reporting false when they share none.
+func intersectScopes(a []uint, b []uint) ([]uint, bool) {
+	if a == nil {
+		return b, b == nil || len(b) > 0
+	}
+	if b == nil {
+		return a, len(a) > 0
+	}
+
+	inB := make(map[uint]bool, len(b))
+	for _, id := range b {
+		inB[id] = true
+	}
+
+	shared := make([]uint, 0, len(a))
+	for _, id := range a {
+		if inB[id] {
+			shared = append(shared, id)
+		}
+	}
+	return shared, len(shared) > 0
+}
+
+// authorizeClosure lets venue owners manage the closures of their own fields and venues, but not the ones
+// covering every field.
+func (c *ClosureService) authorizeClosure(ctx context.Context, closure *models.Closure) error {
+	switch {
+	case closure.FieldID != nil && closure.Field != nil:
+		return venueService.AuthorizeField(ctx, closure.Field)
+	case closure.VenueID != nil:
+		return venueService.AuthorizeVenue(ctx, closure.Venue)
+	default:
+		return venueService.AuthorizeVenue(ctx, nil)
+	}
+}
+
+// resolveClosure parses the date range and loads the field or venue the closure is limited to.
+func (c *ClosureService) resolveClosure(ctx context.Context, request *dto.ClosureRequest) (*models.Closure, error) {
+	startDate, err := time.Parse(time.DateOnly, request.StartDate)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidDate
+	}
+
+	endDate, err := time.Parse(time.DateOnly, request.EndDate)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidDate
+	}
+
+	if endDate.Before(startDate) {
+		return nil, errFieldSchedule.ErrInvalidDateRange
+	}
+
+	if request.FieldID != "" && request.VenueID != "" {
+		return nil, errClosure.ErrClosureScope
+	}
+
+	closure := &models.Closure{
+		StartDate: startDate,
+		EndDate:   endDate,
+		Reason:    request.Reason,
+	}
+	if request.FieldID != "" {
+		field, err := c.repository.GetField().FindByUUID(ctx, request.FieldID)
+		if err != nil {
+			return nil, err
+		}
+		closure.FieldID = &field.ID
+		closure.Field = field
+	}
+	if request.VenueID != "" {
+		venue, err := c.repository.GetVenue().FindByUUID(ctx, request.VenueID)
+		if err != nil {
+			return nil, err
+		}
+		closure.VenueID = &venue.ID
+		closure.Venue = venue
+	}
+
+	err = c.authorizeClosure(ctx, closure)
+	if err != nil {
+		return nil, err
+	}
+	return closure, nil
+}
+
+func (c *ClosureService) toResponse(closure *models.Closure) dto.ClosureResponse {
+	var fieldName, venueName *string
+	if closure.Field != nil {
+		fieldName = &closure.Field.Name
+	}
+	if closure.Venue != nil {
+		venueName = &closure.Venue.Name
+	}
+
+	return dto.ClosureResponse{
+		UUID:      closure.UUID,
+		FieldName: fieldName,
+		VenueName: venueName,
+		StartDate: closure.StartDate.Format(time.DateOnly),
+		EndDate:   closure.EndDate.Format(time.DateOnly),
+		Reason:    closure.Reason,
+		CreatedAt: closure.CreatedAt,
+		UpdatedAt: closure.UpdatedAt,
+	}
+}
+
+func (c *ClosureService) toScheduleResponses(fieldSchedules []models.FieldSchedule) []dto.FieldScheduleResponse {
+	results := make([]dto.FieldScheduleResponse, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		price := fieldSchedule.Field.PricePerHour
+		if fieldSchedule.Price != nil {
+			price = *fieldSchedule.Price
+		}
+		results = append(results, dto.FieldScheduleResponse{
+			UUID:         fieldSchedule.UUID,
+			FieldName:    fieldSchedule.Field.Name,
+			PricePerHour: price,
+			Date:         fieldSchedule.Date.Format(time.DateOnly),
+			Status:       fieldSchedule.Status.GetStatusString(),
+			Time:         fmt.Sprintf("%s - %s", fieldSchedule.Time.StartTime, fieldSchedule.Time.EndTime),
+			CreatedAt:    fieldSchedule.CreatedAt,
+			UpdatedAt:    fieldSchedule.UpdatedAt,
+		})
+	}
+	return results
+}
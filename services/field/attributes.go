@@ -0,0 +1,92 @@
+package services
+
+import (
+	"field-service/constants"
+	errField "field-service/constants/error/field"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"time"
+)
+
+// validateAttributes checks the optional descriptive attributes of a field against the known values.
+func validateAttributes(sportType string, surface string, environment string, facilities []string) error {
+	if sportType != "" && !constants.SportType(sportType).IsValid() {
+		return errField.ErrInvalidSportType
+	}
+	if surface != "" && !constants.FieldSurface(surface).IsValid() {
+		return errField.ErrInvalidSurface
+	}
+	if environment != "" && !constants.FieldEnvironment(environment).IsValid() {
+		return errField.ErrInvalidEnvironment
+	}
+	for _, facility := range facilities {
+		if !constants.FieldFacility(facility).IsValid() {
+			return errField.ErrInvalidFacility
+		}
+	}
+	return nil
+}
+
+// keepAttributes fills the attributes an update leaves out with the field's current values, the same way omitted
+// images keep the ones already uploaded.
+func keepAttributes(update *models.Field, current *models.Field) {
+	if update.SportType == "" {
+		update.SportType = current.SportType
+	}
+	if update.Surface == "" {
+		update.Surface = current.Surface
+	}
+	if update.Environment == "" {
+		update.Environment = current.Environment
+	}
+	if update.Capacity == nil {
+		update.Capacity = current.Capacity
+	}
+	if update.LengthMeter == nil {
+		update.LengthMeter = current.LengthMeter
+	}
+	if update.WidthMeter == nil {
+		update.WidthMeter = current.WidthMeter
+	}
+	if update.Facilities == nil {
+		update.Facilities = current.Facilities
+	}
+}
+
+// validateFilter rejects list filters that are malformed or could never match because the value is not a known
+// attribute.
+func validateFilter(filter *dto.FieldFilterParam) error {
+	if filter.MinPrice != nil && filter.MaxPrice != nil && *filter.MaxPrice < *filter.MinPrice {
+		return errField.ErrInvalidPriceRange
+	}
+
+	var createdFrom, createdTo time.Time
+	var err error
+	if filter.CreatedFrom != nil {
+		createdFrom, err = time.Parse(time.DateOnly, *filter.CreatedFrom)
+		if err != nil {
+			return errField.ErrInvalidCreatedRange
+		}
+	}
+	if filter.CreatedTo != nil {
+		createdTo, err = time.Parse(time.DateOnly, *filter.CreatedTo)
+		if err != nil {
+			return errField.ErrInvalidCreatedRange
+		}
+	}
+	if filter.CreatedFrom != nil && filter.CreatedTo != nil && createdTo.Before(createdFrom) {
+		return errField.ErrInvalidCreatedRange
+	}
+
+	var sportType, surface, environment string
+	if filter.SportType != nil {
+		sportType = *filter.SportType
+	}
+	if filter.Surface != nil {
+		surface = *filter.Surface
+	}
+	if filter.Environment != nil {
+		environment = *filter.Environment
+	}
+	return validateAttributes(sportType, surface, environment, filter.Facilities)
+}
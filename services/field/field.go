@@ -5,10 +5,14 @@ import (
 	"context"
 	"field-service/common/gcs"
 	"field-service/common/util"
+	"field-service/constants"
 	errConst "field-service/constants/error"
+	errVenue "field-service/constants/error/venue"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"field-service/repositories"
+	auditService "field-service/services/auditlog"
+	venueService "field-service/services/venue"
 	"fmt"
 	"io"
 	"mime/multipart"
@@ -16,6 +20,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"gorm.io/gorm"
 )
 
 type FieldService struct {
@@ -25,7 +30,7 @@ type FieldService struct {
 
 type IFieldService interface {
 	GetAllWithPagination(context.Context, *dto.FieldRequestParam) (*util.PaginationResult, error)
-	GetAllWithoutPagination(context.Context) ([]dto.FieldResponse, error)
+	GetAllWithoutPagination(context.Context, *dto.FieldFilterParam) ([]dto.FieldResponse, error)
 	GetByUUID(context.Context, string) (*dto.FieldResponse, error)
 	Create(context.Context, *dto.FieldRequest) (*dto.FieldResponse, error)
 	Update(context.Context, string, *dto.UpdateFieldRequest) (*dto.FieldResponse, error)
@@ -41,49 +46,133 @@ func NewFieldService(repository repositories.IRepositoryRegistry, gcs gcs.IGCSCl
 
 // Create implements IFieldService.
 func (f *FieldService) Create(ctx context.Context, request *dto.FieldRequest) (*dto.FieldResponse, error) {
+	openingTime, closingTime, slotDurationMinute, err := operatingHours(
+		request.OpeningTime, request.ClosingTime, request.SlotDurationMinute)
+	if err != nil {
+		return nil, err
+	}
+
+	venue, err := f.resolveVenue(ctx, request.VenueID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := fieldTimezone(request.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateAttributes(request.SportType, request.Surface, request.Environment, request.Facilities)
+	if err != nil {
+		return nil, err
+	}
+
 	imageUrl, err := f.uploadImage(ctx, request.Images)
 	if err != nil {
 		return nil, err
 	}
 
-	field, err := f.repository.GetField().Create(ctx, &models.Field{
-		Code:         request.Code,
-		Name:         request.Name,
-		PricePerHour: request.PricePerHour,
-		Images:       imageUrl,
+	var field *models.Field
+	err = f.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		field, txErr = f.repository.GetField().Create(ctx, tx, &models.Field{
+			VenueID:            venueID(venue),
+			Code:               request.Code,
+			Name:               request.Name,
+			PricePerHour:       request.PricePerHour,
+			Images:             imageUrl,
+			OpeningTime:        openingTime,
+			ClosingTime:        closingTime,
+			SlotDurationMinute: slotDurationMinute,
+			Timezone:           timezone,
+			SportType:          constants.SportType(request.SportType),
+			Surface:            constants.FieldSurface(request.Surface),
+			Environment:        constants.FieldEnvironment(request.Environment),
+			Capacity:           request.Capacity,
+			LengthMeter:        request.LengthMeter,
+			WidthMeter:         request.WidthMeter,
+			Facilities:         request.Facilities,
+		})
+		if txErr != nil {
+			return txErr
+		}
+
+		field.Venue = venue
+		txErr = f.syncSlots(ctx, tx, field)
+		if txErr != nil {
+			return txErr
+		}
+
+		return auditService.Record(ctx, f.repository, tx, auditService.Entry{
+			EntityType: constants.AuditField,
+			EntityUUID: field.UUID,
+			Action:     constants.AuditCreate,
+			After:      field,
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	response := dto.FieldResponse{
-		UUID:         field.UUID,
-		Code:         field.Code,
-		Name:         field.Name,
-		PricePerHour: field.PricePerHour,
-		Images:       field.Images,
-		CreatedAt:    field.CreatedAt,
-		UpdatedAt:    field.UpdatedAt,
+		UUID:               field.UUID,
+		VenueUUID:          venueUUID(field.Venue),
+		VenueName:          venueName(field.Venue),
+		Code:               field.Code,
+		Name:               field.Name,
+		PricePerHour:       field.PricePerHour,
+		Images:             field.Images,
+		OpeningTime:        field.OpeningTime,
+		ClosingTime:        field.ClosingTime,
+		SlotDurationMinute: field.SlotDurationMinute,
+		Timezone:           venueService.FieldLocation(field).String(),
+		SportType:          field.SportType,
+		Surface:            field.Surface,
+		Environment:        field.Environment,
+		Capacity:           field.Capacity,
+		LengthMeter:        field.LengthMeter,
+		WidthMeter:         field.WidthMeter,
+		Facilities:         field.Facilities,
+		CreatedAt:          field.CreatedAt,
+		UpdatedAt:          field.UpdatedAt,
 	}
 	return &response, nil
 }
 
 // Delete implements IFieldService.
 func (f *FieldService) Delete(ctx context.Context, uuid string) error {
-	_, err := f.repository.GetField().FindByUUID(ctx, uuid)
+	field, err := f.repository.GetField().FindByUUID(ctx, uuid)
 	if err != nil {
 		return err
 	}
 
-	err = f.repository.GetField().Delete(ctx, uuid)
+	err = venueService.AuthorizeField(ctx, field)
 	if err != nil {
 		return err
 	}
-	return nil
+
+	return f.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := f.repository.GetField().Delete(ctx, tx, uuid)
+		if txErr != nil {
+			return txErr
+		}
+
+		return auditService.Record(ctx, f.repository, tx, auditService.Entry{
+			EntityType: constants.AuditField,
+			EntityUUID: field.UUID,
+			Action:     constants.AuditDelete,
+			Before:     field,
+		})
+	})
 }
 
 // GetAllWithPagination implements IFieldService.
 func (f *FieldService) GetAllWithPagination(ctx context.Context, req *dto.FieldRequestParam) (*util.PaginationResult, error) {
+	err := validateFilter(&req.FieldFilterParam)
+	if err != nil {
+		return nil, err
+	}
+
 	fields, total, err := f.repository.GetField().FindAllWithPagination(ctx, req)
 	if err != nil {
 		return nil, err
@@ -92,13 +181,26 @@ func (f *FieldService) GetAllWithPagination(ctx context.Context, req *dto.FieldR
 	fieldResults := make([]dto.FieldResponse, 0, len(fields))
 	for _, field := range fields {
 		fieldResults = append(fieldResults, dto.FieldResponse{
-			UUID:         field.UUID,
-			Code:         field.Code,
-			Name:         field.Name,
-			Images:       field.Images,
-			PricePerHour: field.PricePerHour,
-			CreatedAt:    field.CreatedAt,
-			UpdatedAt:    field.UpdatedAt,
+			UUID:               field.UUID,
+			VenueUUID:          venueUUID(field.Venue),
+			VenueName:          venueName(field.Venue),
+			Code:               field.Code,
+			Name:               field.Name,
+			Images:             field.Images,
+			PricePerHour:       field.PricePerHour,
+			OpeningTime:        field.OpeningTime,
+			ClosingTime:        field.ClosingTime,
+			SlotDurationMinute: field.SlotDurationMinute,
+			Timezone:           venueService.FieldLocation(&field).String(),
+			SportType:          field.SportType,
+			Surface:            field.Surface,
+			Environment:        field.Environment,
+			Capacity:           field.Capacity,
+			LengthMeter:        field.LengthMeter,
+			WidthMeter:         field.WidthMeter,
+			Facilities:         field.Facilities,
+			CreatedAt:          field.CreatedAt,
+			UpdatedAt:          field.UpdatedAt,
 		})
 	}
 	pagination := &util.PaginationParam{
@@ -113,8 +215,13 @@ func (f *FieldService) GetAllWithPagination(ctx context.Context, req *dto.FieldR
 }
 
 // GetAllWithoutPagination implements IFieldService.
-func (f *FieldService) GetAllWithoutPagination(ctx context.Context) ([]dto.FieldResponse, error) {
-	fields, err := f.repository.GetField().FindAllWithoutPagination(ctx)
+func (f *FieldService) GetAllWithoutPagination(ctx context.Context, filter *dto.FieldFilterParam) ([]dto.FieldResponse, error) {
+	err := validateFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := f.repository.GetField().FindAllWithoutPagination(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -125,6 +232,10 @@ func (f *FieldService) GetAllWithoutPagination(ctx context.Context) ([]dto.Field
 			Name:         field.Name,
 			Images:       field.Images,
 			PricePerHour: field.PricePerHour,
+			SportType:    field.SportType,
+			Environment:  field.Environment,
+			Capacity:     field.Capacity,
+			Facilities:   field.Facilities,
 		})
 	}
 	return fieldResults, nil
@@ -139,13 +250,26 @@ func (f *FieldService) GetByUUID(ctx context.Context, uuid string) (*dto.FieldRe
 
 	pricePerHour := float64(field.PricePerHour)
 	fieldResult := dto.FieldResponse{
-		UUID:         field.UUID,
-		Code:         field.Code,
-		Name:         field.Name,
-		PricePerHour: util.RupiahFormat(&pricePerHour),
-		Images:       field.Images,
-		CreatedAt:    field.CreatedAt,
-		UpdatedAt:    field.UpdatedAt,
+		UUID:               field.UUID,
+		VenueUUID:          venueUUID(field.Venue),
+		VenueName:          venueName(field.Venue),
+		Code:               field.Code,
+		Name:               field.Name,
+		PricePerHour:       util.RupiahFormat(&pricePerHour),
+		Images:             field.Images,
+		OpeningTime:        field.OpeningTime,
+		ClosingTime:        field.ClosingTime,
+		SlotDurationMinute: field.SlotDurationMinute,
+		Timezone:           venueService.FieldLocation(field).String(),
+		SportType:          field.SportType,
+		Surface:            field.Surface,
+		Environment:        field.Environment,
+		Capacity:           field.Capacity,
+		LengthMeter:        field.LengthMeter,
+		WidthMeter:         field.WidthMeter,
+		Facilities:         field.Facilities,
+		CreatedAt:          field.CreatedAt,
+		UpdatedAt:          field.UpdatedAt,
 	}
 
 	return &fieldResult, nil
@@ -159,6 +283,32 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, request *dt
 		return nil, err
 	}
 
+	err = venueService.AuthorizeField(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+
+	venue, err := f.resolveVenue(ctx, request.VenueID, field.Venue)
+	if err != nil {
+		return nil, err
+	}
+
+	openingTime, closingTime, slotDurationMinute, err := operatingHours(
+		request.OpeningTime, request.ClosingTime, request.SlotDurationMinute)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone, err := fieldTimezone(request.Timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateAttributes(request.SportType, request.Surface, request.Environment, request.Facilities)
+	if err != nil {
+		return nil, err
+	}
+
 	var imageUrls []string
 	if request.Images == nil {
 		imageUrls = field.Images
@@ -169,26 +319,143 @@ func (f *FieldService) Update(ctx context.Context, uuidParam string, request *dt
 		}
 	}
 
-	fieldResult, err := f.repository.GetField().Update(ctx, uuidParam, &models.Field{
-		Code:         request.Code,
-		Name:         request.Name,
-		PricePerHour: request.PricePerHour,
-		Images:       imageUrls,
+	update := &models.Field{
+		VenueID:            venueID(venue),
+		Code:               request.Code,
+		Name:               request.Name,
+		PricePerHour:       request.PricePerHour,
+		Images:             imageUrls,
+		OpeningTime:        openingTime,
+		ClosingTime:        closingTime,
+		SlotDurationMinute: slotDurationMinute,
+		Timezone:           timezone,
+		SportType:          constants.SportType(request.SportType),
+		Surface:            constants.FieldSurface(request.Surface),
+		Environment:        constants.FieldEnvironment(request.Environment),
+		Capacity:           request.Capacity,
+		LengthMeter:        request.LengthMeter,
+		WidthMeter:         request.WidthMeter,
+		Facilities:         request.Facilities,
+	}
+	keepAttributes(update, field)
+	keepOperatingHours(update, field)
+	if update.Timezone == nil {
+		update.Timezone = field.Timezone
+	}
+
+	var fieldResult *models.Field
+	err = f.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		fieldResult, txErr = f.repository.GetField().Update(ctx, tx, uuidParam, update)
+		if txErr != nil {
+			return txErr
+		}
+
+		fieldResult.ID = field.ID
+		fieldResult.UUID = field.UUID
+		fieldResult.CreatedAt = field.CreatedAt
+		fieldResult.Venue = venue
+		txErr = f.syncSlots(ctx, tx, fieldResult)
+		if txErr != nil {
+			return txErr
+		}
+
+		return auditService.Record(ctx, f.repository, tx, auditService.Entry{
+			EntityType: constants.AuditField,
+			EntityUUID: field.UUID,
+			Action:     constants.AuditUpdate,
+			Before:     field,
+			After:      fieldResult,
+		})
 	})
+	if err != nil {
+		return nil, err
+	}
 
 	uuidParsed, _ := uuid.Parse(uuidParam)
 	response := dto.FieldResponse{
-		UUID:         uuidParsed,
-		Code:         fieldResult.Code,
-		Name:         fieldResult.Name,
-		PricePerHour: fieldResult.PricePerHour,
-		Images:       fieldResult.Images,
-		CreatedAt:    fieldResult.CreatedAt,
-		UpdatedAt:    fieldResult.UpdatedAt,
+		UUID:               uuidParsed,
+		VenueUUID:          venueUUID(fieldResult.Venue),
+		VenueName:          venueName(fieldResult.Venue),
+		Code:               fieldResult.Code,
+		Name:               fieldResult.Name,
+		PricePerHour:       fieldResult.PricePerHour,
+		Images:             fieldResult.Images,
+		OpeningTime:        fieldResult.OpeningTime,
+		ClosingTime:        fieldResult.ClosingTime,
+		SlotDurationMinute: fieldResult.SlotDurationMinute,
+		Timezone:           venueService.FieldLocation(fieldResult).String(),
+		SportType:          fieldResult.SportType,
+		Surface:            fieldResult.Surface,
+		Environment:        fieldResult.Environment,
+		Capacity:           fieldResult.Capacity,
+		LengthMeter:        fieldResult.LengthMeter,
+		WidthMeter:         fieldResult.WidthMeter,
+		Facilities:         fieldResult.Facilities,
+		CreatedAt:          fieldResult.CreatedAt,
+		UpdatedAt:          fieldResult.UpdatedAt,
 	}
 	return &response, nil
 }
 
+// resolveVenue loads the venue a field is assigned to and checks the user may manage it. Without a venue UUID
+// the current venue is kept; venue owners always have to place their fields in one of their venues.
+func (f *FieldService) resolveVenue(ctx context.Context, uuid string, current *models.Venue) (*models.Venue, error) {
+	venue := current
+	if uuid != "" {
+		var err error
+		venue, err = f.repository.GetVenue().FindByUUID(ctx, uuid)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	user := venueService.CurrentUser(ctx)
+	if venue == nil && user != nil && user.Role == constants.VenueOwner {
+		return nil, errVenue.ErrVenueRequired
+	}
+
+	if venue != nil {
+		err := venueService.AuthorizeVenue(ctx, venue)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return venue, nil
+}
+
+// fieldTimezone validates the optional timezone override of a field. Empty keeps the field on its venue's timezone.
+func fieldTimezone(timezone string) (*string, error) {
+	if timezone == "" {
+		return nil, nil
+	}
+	if _, err := time.LoadLocation(timezone); err != nil {
+		return nil, errVenue.ErrInvalidTimezone
+	}
+	return &timezone, nil
+}
+
+func venueID(venue *models.Venue) *uint {
+	if venue == nil {
+		return nil
+	}
+	return &venue.ID
+}
+
+func venueUUID(venue *models.Venue) *uuid.UUID {
+	if venue == nil {
+		return nil
+	}
+	return &venue.UUID
+}
+
+func venueName(venue *models.Venue) *string {
+	if venue == nil {
+		return nil
+	}
+	return &venue.Name
+}
+
 func (f *FieldService) validateUpload(images []multipart.FileHeader) error {
 	// Check if images is nil or empty
 	if images == nil || len(images) == 0 {
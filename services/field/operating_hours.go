@@ -0,0 +1,142 @@
+package services
+
+import (
+	"context"
+	"field-service/common/util"
+	errField "field-service/constants/error/field"
+	"field-service/domain/models"
+	venueService "field-service/services/venue"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// operatingHours validates the opening time, closing time and slot duration sent for a field. They are either
+// all empty, leaving the field on the global time presets, or all set. A closing time of 00:00 means midnight.
+func operatingHours(openingTime string, closingTime string, slotDurationMinute int) (*string, *string, *int, error) {
+	if openingTime == "" && closingTime == "" && slotDurationMinute == 0 {
+		return nil, nil, nil, nil
+	}
+
+	if openingTime == "" || closingTime == "" || slotDurationMinute == 0 {
+		return nil, nil, nil, errField.ErrInvalidOperatingHours
+	}
+
+	opening, openingMinute, err := util.ParseClock(openingTime)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	closing, closingMinute, err := util.ParseClock(closingTime)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if closingMinute == 0 {
+		closingMinute = util.MinutesPerDay
+	}
+	if closingMinute <= openingMinute {
+		return nil, nil, nil, errField.ErrInvalidOperatingHours
+	}
+	if slotDurationMinute > closingMinute-openingMinute {
+		return nil, nil, nil, errField.ErrInvalidSlotDuration
+	}
+
+	return &opening, &closing, &slotDurationMinute, nil
+}
+
+// keepOperatingHours keeps the field's current operating hours when an update sends none of them, so an update
+// that only touches other fields does not drop the field back to the global time presets.
+func keepOperatingHours(update *models.Field, current *models.Field) {
+	if update.OpeningTime == nil && update.ClosingTime == nil && update.SlotDurationMinute == nil {
+		update.OpeningTime = current.OpeningTime
+		update.ClosingTime = current.ClosingTime
+		update.SlotDurationMinute = current.SlotDurationMinute
+	}
+}
+
+// deriveSlots cuts the field's operating hours into consecutive slots. A trailing remainder shorter than the
+// slot duration is not offered.
+func deriveSlots(field *models.Field) []models.Time {
+	if field.OpeningTime == nil || field.ClosingTime == nil || field.SlotDurationMinute == nil {
+		return nil
+	}
+
+	_, openingMinute, err := util.ParseClock(*field.OpeningTime)
+	if err != nil {
+		return nil
+	}
+	_, closingMinute, err := util.ParseClock(*field.ClosingTime)
+	if err != nil {
+		return nil
+	}
+	if closingMinute == 0 {
+		closingMinute = util.MinutesPerDay
+	}
+
+	duration := *field.SlotDurationMinute
+	slots := make([]models.Time, 0, (closingMinute-openingMinute)/duration)
+	for start := openingMinute; start+duration <= closingMinute; start += duration {
+		end := start + duration
+		slots = append(slots, models.Time{
+			FieldID:         &field.ID,
+			StartTime:       util.FormatClock(start),
+			EndTime:         util.FormatClock(end % util.MinutesPerDay),
+			CrossesMidnight: end == util.MinutesPerDay,
+			IsActive:        true,
+		})
+	}
+	return slots
+}
+
+// syncSlots brings the field's own time slots in line with its operating hours within the transaction that
+// saved the field. Slots that are no longer offered are deactivated and their unsold schedules from today on
+// are removed, so booked history is kept.
+func (f *FieldService) syncSlots(ctx context.Context, tx *gorm.DB, field *models.Field) error {
+	desired := deriveSlots(field)
+	existing, err := f.repository.GetTime().FindAllByFieldID(ctx, tx, field.ID)
+	if err != nil {
+		return err
+	}
+
+	desiredByKey := make(map[string]bool, len(desired))
+	for _, slot := range desired {
+		desiredByKey[slotKey(slot)] = true
+	}
+
+	existingByKey := make(map[string]bool, len(existing))
+	for _, slot := range existing {
+		key := slotKey(slot)
+		existingByKey[key] = true
+		isActive := desiredByKey[key]
+		if slot.IsActive == isActive {
+			continue
+		}
+
+		slot.IsActive = isActive
+		_, err = f.repository.GetTime().Update(ctx, tx, slot.UUID.String(), &slot)
+		if err != nil {
+			return err
+		}
+
+		if !isActive {
+			_, err = f.repository.GetFieldSchedule().DeleteUnbookedByTimeID(
+				ctx, tx, slot.ID, venueService.DefaultLocation())
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	missing := make([]models.Time, 0, len(desired))
+	for _, slot := range desired {
+		if !existingByKey[slotKey(slot)] {
+			missing = append(missing, slot)
+		}
+	}
+	return f.repository.GetTime().CreateAll(ctx, tx, missing)
+}
+
+func slotKey(slot models.Time) string {
+	return fmt.Sprintf("%s-%s", slot.StartTime, slot.EndTime)
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	"field-service/domain/models"
+	auditService "field-service/services/auditlog"
+
+	"gorm.io/gorm"
+)
+
+// recordCreated records the schedules the insert actually created, within the transaction that inserted them.
+func (s *FieldScheduleService) recordCreated(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldSchedules []models.FieldSchedule,
+) error {
+	entries := make([]auditService.Entry, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		entries = append(entries, auditService.Entry{
+			EntityType: constants.AuditFieldSchedule,
+			EntityUUID: fieldSchedule.UUID,
+			Action:     constants.AuditCreate,
+			After:      fieldSchedule,
+		})
+	}
+	return auditService.Record(ctx, s.repository, tx, entries...)
+}
+
+func (s *FieldScheduleService) recordChange(
+	ctx context.Context,
+	tx *gorm.DB,
+	action constants.AuditAction,
+	before *models.FieldSchedule,
+	after *models.FieldSchedule,
+) error {
+	entry := auditService.Entry{
+		EntityType: constants.AuditFieldSchedule,
+		Action:     action,
+	}
+	if before != nil {
+		entry.EntityUUID = before.UUID
+		entry.Before = before
+	}
+	if after != nil {
+		entry.EntityUUID = after.UUID
+		entry.After = after
+	}
+	return auditService.Record(ctx, s.repository, tx, entry)
+}
+
+// recordStatusChange records the move of every schedule to its new state within the transaction that made it.
+func (s *FieldScheduleService) recordStatusChange(
+	ctx context.Context,
+	tx *gorm.DB,
+	before []models.FieldSchedule,
+	after []models.FieldSchedule,
+) error {
+	entries := make([]auditService.Entry, 0, len(before))
+	for i, fieldSchedule := range before {
+		entries = append(entries, auditService.Entry{
+			EntityType: constants.AuditFieldSchedule,
+			EntityUUID: fieldSchedule.UUID,
+			Action:     constants.AuditStatusChange,
+			Before:     fieldSchedule,
+			After:      after[i],
+		})
+	}
+	return auditService.Record(ctx, s.repository, tx, entries...)
+}
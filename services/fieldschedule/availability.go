@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"field-service/common/util"
+	"field-service/constants"
+	errField "field-service/constants/error/field"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errTime "field-service/constants/error/time"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	venueService "field-service/services/venue"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SearchAvailability implements IFieldScheduleService. Slots that have already started in their field's
+// timezone are left out, so searching today only returns what can still be played. Without a date, today is
+// each field's own today. Expired holds are offered as available without being swept.
+func (s *FieldScheduleService) SearchAvailability(
+	ctx context.Context,
+	param *dto.FieldAvailabilityRequestParam,
+) ([]dto.FieldAvailabilityResponse, error) {
+	searchToday := param.Date == "" && param.StartDate == "" && param.EndDate == ""
+	err := s.normalizeAvailabilityParam(param)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldSchedules, err := s.repository.GetFieldSchedule().FindAllAvailable(ctx, param)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	results := make([]dto.FieldAvailabilityResponse, 0)
+	indexByField := make(map[uint]int)
+	for _, fieldSchedule := range fieldSchedules {
+		loc := venueService.FieldLocation(&fieldSchedule.Field)
+		if searchToday &&
+			fieldSchedule.Date.Format(time.DateOnly) != venueService.Today(loc).Format(time.DateOnly) {
+			continue
+		}
+
+		start, err := slotStart(fieldSchedule, loc)
+		if err != nil {
+			return nil, err
+		}
+		if !start.After(now) {
+			continue
+		}
+
+		index, ok := indexByField[fieldSchedule.FieldID]
+		if !ok {
+			index = len(results)
+			indexByField[fieldSchedule.FieldID] = index
+			results = append(results, dto.FieldAvailabilityResponse{
+				FieldUUID: fieldSchedule.Field.UUID,
+				FieldName: fieldSchedule.Field.Name,
+				VenueUUID: venueUUID(fieldSchedule.Field.Venue),
+				VenueName: venueName(fieldSchedule.Field.Venue),
+				Slots:     make([]dto.FieldScheduleBookingResponse, 0),
+			})
+		}
+
+		pricePerHour := float64(effectivePrice(fieldSchedule))
+		startTime, _ := time.Parse(time.TimeOnly, fieldSchedule.Time.StartTime)
+		endTime, _ := time.Parse(time.TimeOnly, fieldSchedule.Time.EndTime)
+		results[index].Slots = append(results[index].Slots, dto.FieldScheduleBookingResponse{
+			UUID:         fieldSchedule.UUID,
+			PricePerHour: util.RupiahFormat(&pricePerHour),
+			Date:         s.convertMonthName(fieldSchedule.Date.Format(time.DateOnly)),
+			Status:       constants.AvailableString,
+			Time:         fmt.Sprintf("%s - %s", startTime.Format("15:04"), endTime.Format("15:04")),
+		})
+	}
+	return results, nil
+}
+
+// normalizeAvailabilityParam validates the search and rewrites it into the form the repository expects: a date
+// range and times as HH:MM:SS. Without any date the range spans the day either side of today in the default
+// timezone, which covers today in every field's timezone. An end time of 00:00 means midnight and does not
+// narrow the search.
+func (s *FieldScheduleService) normalizeAvailabilityParam(param *dto.FieldAvailabilityRequestParam) error {
+	if param.Date != "" {
+		param.StartDate = param.Date
+		param.EndDate = param.Date
+	}
+	if param.StartDate == "" && param.EndDate == "" {
+		today := venueService.Today(venueService.DefaultLocation())
+		param.StartDate = today.AddDate(0, 0, -1).Format(time.DateOnly)
+		param.EndDate = today.AddDate(0, 0, 1).Format(time.DateOnly)
+	}
+	if param.StartDate == "" {
+		param.StartDate = param.EndDate
+	}
+	if param.EndDate == "" {
+		param.EndDate = param.StartDate
+	}
+
+	startDate, err := time.Parse(time.DateOnly, param.StartDate)
+	if err != nil {
+		return errFieldSchedule.ErrInvalidDate
+	}
+	endDate, err := time.Parse(time.DateOnly, param.EndDate)
+	if err != nil {
+		return errFieldSchedule.ErrInvalidDate
+	}
+	if endDate.Before(startDate) ||
+		endDate.After(startDate.AddDate(0, 0, constants.MaxAvailabilitySearchDays-1)) {
+		return errFieldSchedule.ErrInvalidDateRange
+	}
+
+	if param.StartTime != "" {
+		param.StartTime, _, err = util.ParseClock(param.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+	if param.EndTime != "" {
+		param.EndTime, _, err = util.ParseClock(param.EndTime)
+		if err != nil {
+			return err
+		}
+		if param.EndTime == "00:00:00" {
+			param.EndTime = ""
+		}
+	}
+	if param.StartTime != "" && param.EndTime != "" && param.EndTime <= param.StartTime {
+		return errTime.ErrInvalidTimeRange
+	}
+
+	if param.SportType != "" && !constants.SportType(param.SportType).IsValid() {
+		return errField.ErrInvalidSportType
+	}
+	if param.MinPrice != nil && param.MaxPrice != nil && *param.MaxPrice < *param.MinPrice {
+		return errFieldSchedule.ErrInvalidPriceRange
+	}
+	return nil
+}
+
+// slotStart returns the moment the slot begins in the field's timezone.
+func slotStart(fieldSchedule models.FieldSchedule, loc *time.Location) (time.Time, error) {
+	_, startMinute, err := util.ParseClock(fieldSchedule.Time.StartTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Date(
+		fieldSchedule.Date.Year(), fieldSchedule.Date.Month(), fieldSchedule.Date.Day(),
+		startMinute/60, startMinute%60, 0, 0, loc,
+	), nil
+}
+
+func venueUUID(venue *models.Venue) *uuid.UUID {
+	if venue == nil {
+		return nil
+	}
+	return &venue.UUID
+}
+
+func venueName(venue *models.Venue) *string {
+	if venue == nil {
+		return nil
+	}
+	return &venue.Name
+}
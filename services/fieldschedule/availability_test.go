@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+	errField "field-service/constants/error/field"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errTime "field-service/constants/error/time"
+	"field-service/domain/dto"
+	venueService "field-service/services/venue"
+	"testing"
+	"time"
+)
+
+func TestNormalizeAvailabilityParam(t *testing.T) {
+	today := venueService.Today(venueService.DefaultLocation())
+	yesterday := today.AddDate(0, 0, -1).Format(time.DateOnly)
+	tomorrow := today.AddDate(0, 0, 1).Format(time.DateOnly)
+	minPrice, maxPrice := 200000, 100000
+
+	tests := []struct {
+		name    string
+		param   dto.FieldAvailabilityRequestParam
+		want    dto.FieldAvailabilityRequestParam
+		wantErr error
+	}{
+		{
+			name:  "defaults to the days around today so every timezone's today is covered",
+			param: dto.FieldAvailabilityRequestParam{},
+			want:  dto.FieldAvailabilityRequestParam{StartDate: yesterday, EndDate: tomorrow},
+		},
+		{
+			name: "single date sets both ends of the range",
+			param: dto.FieldAvailabilityRequestParam{
+				Date: "2024-06-01", StartDate: "2024-05-01", EndDate: "2024-05-31",
+			},
+			want: dto.FieldAvailabilityRequestParam{
+				Date: "2024-06-01", StartDate: "2024-06-01", EndDate: "2024-06-01",
+			},
+		},
+		{
+			name:  "end date defaults to the start date",
+			param: dto.FieldAvailabilityRequestParam{StartDate: "2024-06-01"},
+			want:  dto.FieldAvailabilityRequestParam{StartDate: "2024-06-01", EndDate: "2024-06-01"},
+		},
+		{
+			name:  "start date defaults to the end date",
+			param: dto.FieldAvailabilityRequestParam{EndDate: "2024-06-01"},
+			want:  dto.FieldAvailabilityRequestParam{StartDate: "2024-06-01", EndDate: "2024-06-01"},
+		},
+		{
+			name:  "longest range allowed",
+			param: dto.FieldAvailabilityRequestParam{StartDate: "2024-06-01", EndDate: "2024-07-01"},
+			want:  dto.FieldAvailabilityRequestParam{StartDate: "2024-06-01", EndDate: "2024-07-01"},
+		},
+		{
+			name:    "range one day too long",
+			param:   dto.FieldAvailabilityRequestParam{StartDate: "2024-06-01", EndDate: "2024-07-02"},
+			wantErr: errFieldSchedule.ErrInvalidDateRange,
+		},
+		{
+			name:    "end date before start date",
+			param:   dto.FieldAvailabilityRequestParam{StartDate: "2024-06-02", EndDate: "2024-06-01"},
+			wantErr: errFieldSchedule.ErrInvalidDateRange,
+		},
+		{
+			name:    "malformed date",
+			param:   dto.FieldAvailabilityRequestParam{Date: "01-06-2024"},
+			wantErr: errFieldSchedule.ErrInvalidDate,
+		},
+		{
+			name:  "times are normalized to HH:MM:SS",
+			param: dto.FieldAvailabilityRequestParam{Date: "2024-06-01", StartTime: "18:00", EndTime: "22:30"},
+			want: dto.FieldAvailabilityRequestParam{
+				Date: "2024-06-01", StartDate: "2024-06-01", EndDate: "2024-06-01",
+				StartTime: "18:00:00", EndTime: "22:30:00",
+			},
+		},
+		{
+			name:  "end time at midnight does not narrow the search",
+			param: dto.FieldAvailabilityRequestParam{Date: "2024-06-01", StartTime: "18:00", EndTime: "00:00"},
+			want: dto.FieldAvailabilityRequestParam{
+				Date: "2024-06-01", StartDate: "2024-06-01", EndDate: "2024-06-01", StartTime: "18:00:00",
+			},
+		},
+		{
+			name:    "end time not after start time",
+			param:   dto.FieldAvailabilityRequestParam{Date: "2024-06-01", StartTime: "18:00", EndTime: "18:00"},
+			wantErr: errTime.ErrInvalidTimeRange,
+		},
+		{
+			name:    "malformed time",
+			param:   dto.FieldAvailabilityRequestParam{Date: "2024-06-01", StartTime: "6pm"},
+			wantErr: errTime.ErrInvalidTimeFormat,
+		},
+		{
+			name:    "unknown sport type",
+			param:   dto.FieldAvailabilityRequestParam{Date: "2024-06-01", SportType: "curling"},
+			wantErr: errField.ErrInvalidSportType,
+		},
+		{
+			name:    "minimum price above maximum price",
+			param:   dto.FieldAvailabilityRequestParam{Date: "2024-06-01", MinPrice: &minPrice, MaxPrice: &maxPrice},
+			wantErr: errFieldSchedule.ErrInvalidPriceRange,
+		},
+	}
+
+	s := &FieldScheduleService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			param := tt.param
+			err := s.normalizeAvailabilityParam(&param)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("normalizeAvailabilityParam() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && param != tt.want {
+				t.Errorf("normalizeAvailabilityParam() = %+v, want %+v", param, tt.want)
+			}
+		})
+	}
+}
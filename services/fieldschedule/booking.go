@@ -0,0 +1,168 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	venueService "field-service/services/venue"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// GetHistory implements IFieldScheduleService. It lists the status transitions of the schedule, oldest first.
+func (s *FieldScheduleService) GetHistory(ctx context.Context, uuid string) ([]dto.FieldScheduleHistoryResponse, error) {
+	fieldSchedule, err := s.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venueService.AuthorizeField(ctx, &fieldSchedule.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	histories, err := s.repository.GetFieldSchedule().FindAllHistoriesByFieldScheduleID(ctx, fieldSchedule.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := venueService.FieldLocation(&fieldSchedule.Field)
+	results := make([]dto.FieldScheduleHistoryResponse, 0, len(histories))
+	for _, history := range histories {
+		results = append(results, dto.FieldScheduleHistoryResponse{
+			UUID:             history.UUID,
+			FromStatus:       history.FromStatus.GetStatusString(),
+			ToStatus:         history.ToStatus.GetStatusString(),
+			Reason:           history.Reason,
+			BookingReference: history.BookingReference,
+			CustomerUUID:     history.CustomerUUID,
+			ActorUUID:        history.ActorUUID,
+			ServiceName:      history.ServiceName,
+			CreatedAt:        venueService.InLocation(history.CreatedAt, loc),
+		})
+	}
+	return results, nil
+}
+
+// newBooking returns nil when the request names neither a booking reference nor a customer.
+func newBooking(bookingReference *string, customerUUID *string) (*models.Booking, error) {
+	if bookingReference == nil && customerUUID == nil {
+		return nil, nil
+	}
+
+	booking := &models.Booking{BookingReference: bookingReference}
+	if customerUUID != nil {
+		parsed, err := uuid.Parse(*customerUUID)
+		if err != nil {
+			return nil, errFieldSchedule.ErrInvalidCustomerUUID
+		}
+		booking.CustomerUUID = &parsed
+	}
+	return booking, nil
+}
+
+// changeStatus moves the locked schedules to the status and records the transitions, with the reason they were
+// made, within the transaction. It returns the schedules as persisted, in the same order.
+func (s *FieldScheduleService) changeStatus(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldSchedules []models.FieldSchedule,
+	status constants.FieldScheduleStatus,
+	reason constants.FieldScheduleChangeReason,
+	holdExpiresAt *time.Time,
+	booking *models.Booking,
+) ([]models.FieldSchedule, error) {
+	err := s.repository.GetFieldSchedule().UpdateStatusByIDs(
+		ctx, tx, s.scheduleIDs(fieldSchedules), status, holdExpiresAt, booking)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]string, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		uuids = append(uuids, fieldSchedule.UUID.String())
+	}
+	updated, err := s.repository.GetFieldSchedule().FindAllByUUIDsForUpdate(ctx, tx, uuids)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedByID := make(map[uint]models.FieldSchedule, len(updated))
+	for _, fieldSchedule := range updated {
+		updatedByID[fieldSchedule.ID] = fieldSchedule
+	}
+	after := make([]models.FieldSchedule, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		after = append(after, updatedByID[fieldSchedule.ID])
+	}
+
+	err = s.recordHistory(ctx, tx, reason, fieldSchedules, after)
+	if err != nil {
+		return nil, err
+	}
+	err = s.recordStatusChange(ctx, tx, fieldSchedules, after)
+	if err != nil {
+		return nil, err
+	}
+	return after, nil
+}
+
+// recordHistory writes one transition per schedule. A release keeps the booking the slot carried before, so the
+// history still tells whose hold or booking was given up.
+func (s *FieldScheduleService) recordHistory(
+	ctx context.Context,
+	tx *gorm.DB,
+	reason constants.FieldScheduleChangeReason,
+	before []models.FieldSchedule,
+	after []models.FieldSchedule,
+) error {
+	var actorUUID *uuid.UUID
+	if user := venueService.CurrentUser(ctx); user != nil {
+		actorUUID = &user.UUID
+	}
+	var serviceName *string
+	if value, ok := ctx.Value(constants.ServiceName).(string); ok && value != "" {
+		serviceName = &value
+	}
+
+	histories := make([]models.FieldScheduleHistory, 0, len(before))
+	for i, fieldSchedule := range before {
+		booking := after[i].Booking
+		if after[i].Status == constants.Available {
+			booking = fieldSchedule.Booking
+		}
+		histories = append(histories, models.FieldScheduleHistory{
+			FieldScheduleID:  fieldSchedule.ID,
+			FromStatus:       fieldSchedule.Status,
+			ToStatus:         after[i].Status,
+			Reason:           reason,
+			BookingReference: booking.BookingReference,
+			CustomerUUID:     booking.CustomerUUID,
+			ActorUUID:        actorUUID,
+			ServiceName:      serviceName,
+		})
+	}
+	return s.repository.GetFieldSchedule().CreateHistories(ctx, tx, histories)
+}
+
+// bookingDetail shows the booking of the slot to admins and to the owner of its venue; the field must be loaded
+// with its venue.
+func bookingDetail(ctx context.Context, fieldSchedule models.FieldSchedule) *dto.FieldScheduleBookingDetail {
+	user := venueService.CurrentUser(ctx)
+	if user == nil || (user.Role != constants.Admin && user.Role != constants.VenueOwner) {
+		return nil
+	}
+	if venueService.AuthorizeField(ctx, &fieldSchedule.Field) != nil {
+		return nil
+	}
+
+	return &dto.FieldScheduleBookingDetail{
+		BookingReference: fieldSchedule.BookingReference,
+		CustomerUUID:     fieldSchedule.CustomerUUID,
+		BookedAt:         venueService.InLocation(fieldSchedule.BookedAt, venueService.FieldLocation(&fieldSchedule.Field)),
+	}
+}
@@ -0,0 +1,108 @@
+package services
+
+import (
+	"context"
+	"field-service/config"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	venueService "field-service/services/venue"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Cancel implements IFieldScheduleService. Booked schedules become available again, or blocked when their date
+// has been closed, as long as none of them starts within the cancellation cutoff; the start is taken in the
+// field's timezone. The history records the transition as a cancellation.
+func (s *FieldScheduleService) Cancel(
+	ctx context.Context,
+	request *dto.CancelFieldScheduleRequest,
+) (*dto.FieldScheduleHoldResponse, error) {
+	deadline := time.Now().Add(time.Duration(cancellationCutoffHour()) * time.Hour)
+	var fieldSchedules, cancelled []models.FieldSchedule
+	err := s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		fieldSchedules, txErr = s.lockSchedules(ctx, tx, request.FieldScheduleIDs)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.checkCancellable(ctx, tx, fieldSchedules, request.BookingReference, deadline)
+		if txErr != nil {
+			return txErr
+		}
+
+		cancelled, txErr = s.changeStatus(ctx, tx, fieldSchedules, constants.Available, constants.ChangeCancel, nil, nil)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toHoldResponse(fieldSchedules, constants.Available, nil)
+	response.BlockedFieldScheduleIDs = blockedScheduleIDs(cancelled)
+	return response, nil
+}
+
+// checkCancellable fails unless every schedule is booked, under the booking reference when one is given, and
+// starts after the deadline.
+func (s *FieldScheduleService) checkCancellable(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldSchedules []models.FieldSchedule,
+	bookingReference *string,
+	deadline time.Time,
+) error {
+	for _, fieldSchedule := range fieldSchedules {
+		if fieldSchedule.Status != constants.Booked {
+			return errFieldSchedule.ErrFieldScheduleNotBooked
+		}
+		if bookingReference != nil &&
+			(fieldSchedule.BookingReference == nil || *fieldSchedule.BookingReference != *bookingReference) {
+			return errFieldSchedule.ErrBookingReferenceMismatch
+		}
+	}
+
+	details, err := s.repository.GetFieldSchedule().FindAllByIDs(ctx, tx, s.scheduleIDs(fieldSchedules))
+	if err != nil {
+		return err
+	}
+	for _, detail := range details {
+		err = checkCutoff(detail, deadline)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkCutoff fails when the slot, loaded with its field, starts before the deadline in the field's timezone. A
+// slot starting exactly at the deadline can still be cancelled.
+func checkCutoff(fieldSchedule models.FieldSchedule, deadline time.Time) error {
+	start, err := slotStart(fieldSchedule, venueService.FieldLocation(&fieldSchedule.Field))
+	if err != nil {
+		return err
+	}
+	if start.Before(deadline) {
+		return errFieldSchedule.ErrCancellationClosed
+	}
+	return nil
+}
+
+// checkOverride refuses to move or delete a booked schedule unless the caller explicitly overrides it.
+func checkOverride(fieldSchedule *models.FieldSchedule, override bool) error {
+	if fieldSchedule.Status == constants.Booked && !override {
+		return errFieldSchedule.ErrFieldScheduleBooked
+	}
+	return nil
+}
+
+func cancellationCutoffHour() int {
+	cutoffHour := config.Config.CancellationCutoffHour
+	if cutoffHour <= 0 {
+		cutoffHour = constants.DefaultCancellationCutoffHour
+	}
+	return cutoffHour
+}
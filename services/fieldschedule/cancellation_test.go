@@ -0,0 +1,173 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errTime "field-service/constants/error/time"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"testing"
+	"time"
+)
+
+func TestCheckCutoff(t *testing.T) {
+	jakarta := "Asia/Jakarta"
+	utc := "UTC"
+	slot := func(field models.Field, start string) models.FieldSchedule {
+		return models.FieldSchedule{
+			Date:  time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+			Time:  models.Time{StartTime: start},
+			Field: field,
+		}
+	}
+	// 19:00 in Jakarta is 12:00 UTC.
+	start := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		fieldSchedule models.FieldSchedule
+		deadline      time.Time
+		wantErr       error
+	}{
+		{
+			name:          "starts after the deadline",
+			fieldSchedule: slot(models.Field{Timezone: &jakarta}, "19:00:00"),
+			deadline:      start.Add(-time.Minute),
+		},
+		{
+			name:          "starts exactly at the deadline",
+			fieldSchedule: slot(models.Field{Timezone: &jakarta}, "19:00:00"),
+			deadline:      start,
+		},
+		{
+			name:          "starts just before the deadline",
+			fieldSchedule: slot(models.Field{Timezone: &jakarta}, "19:00:00"),
+			deadline:      start.Add(time.Nanosecond),
+			wantErr:       errFieldSchedule.ErrCancellationClosed,
+		},
+		{
+			name:          "venue timezone when the field has none",
+			fieldSchedule: slot(models.Field{Venue: &models.Venue{Timezone: jakarta}}, "19:00:00"),
+			deadline:      start.Add(time.Minute),
+			wantErr:       errFieldSchedule.ErrCancellationClosed,
+		},
+		{
+			name:          "same clock time in a later timezone is still open",
+			fieldSchedule: slot(models.Field{Timezone: &utc}, "19:00:00"),
+			deadline:      start.Add(time.Minute),
+		},
+		{
+			name:          "malformed start time",
+			fieldSchedule: slot(models.Field{Timezone: &jakarta}, "7pm"),
+			deadline:      start,
+			wantErr:       errTime.ErrInvalidTimeFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkCutoff(tt.fieldSchedule, tt.deadline)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkCutoff() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   constants.FieldScheduleStatus
+		override bool
+		wantErr  error
+	}{
+		{name: "available", status: constants.Available},
+		{name: "booked without override", status: constants.Booked, wantErr: errFieldSchedule.ErrFieldScheduleBooked},
+		{name: "booked with override", status: constants.Booked, override: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkOverride(&models.FieldSchedule{Status: tt.status}, tt.override)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkOverride() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCancel(t *testing.T) {
+	t.Run("makes the booking available and records the cancellation", func(t *testing.T) {
+		fieldSchedule := newTestSchedule(1, constants.Booked, 7, 100000)
+		fieldSchedule.BookingReference = ptr("ORDER-1")
+		registry := newFakeRegistry(t, fieldSchedule)
+		s := &FieldScheduleService{repository: registry}
+
+		response, err := s.Cancel(context.Background(), &dto.CancelFieldScheduleRequest{
+			FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+			BookingReference: ptr("ORDER-1"),
+		})
+		if err != nil {
+			t.Fatalf("Cancel() error = %v", err)
+		}
+
+		registry.assertTx(t, 1, 0)
+		cancelled := registry.fieldSchedule.schedules[1]
+		if cancelled.Status != constants.Available || cancelled.BookingReference != nil {
+			t.Errorf("slot = status %d under %v, want available and cleared",
+				cancelled.Status, cancelled.BookingReference)
+		}
+		histories := registry.fieldSchedule.histories
+		if len(histories) != 1 || histories[0].FromStatus != constants.Booked ||
+			histories[0].Reason != constants.ChangeCancel ||
+			histories[0].BookingReference == nil || *histories[0].BookingReference != "ORDER-1" {
+			t.Errorf("histories = %+v, want one cancellation under ORDER-1", histories)
+		}
+		if len(registry.auditLog.auditLogs) != 1 {
+			t.Errorf("wrote %d audit logs, want 1", len(registry.auditLog.auditLogs))
+		}
+		if response.Status != constants.AvailableString {
+			t.Errorf("response status = %s, want %s", response.Status, constants.AvailableString)
+		}
+	})
+
+	tests := []struct {
+		name      string
+		status    constants.FieldScheduleStatus
+		daysAhead int
+		reference *string
+		wantErr   error
+	}{
+		{name: "slot that is not booked", status: constants.Held, daysAhead: 7,
+			wantErr: errFieldSchedule.ErrFieldScheduleNotBooked},
+		{name: "booking under another reference", status: constants.Booked, daysAhead: 7,
+			reference: ptr("ORDER-2"), wantErr: errFieldSchedule.ErrBookingReferenceMismatch},
+		{name: "booking inside the cutoff", status: constants.Booked, daysAhead: 0,
+			wantErr: errFieldSchedule.ErrCancellationClosed},
+	}
+	for _, tt := range tests {
+		t.Run("refuses a "+tt.name, func(t *testing.T) {
+			fieldSchedule := newTestSchedule(1, tt.status, tt.daysAhead, 100000)
+			fieldSchedule.BookingReference = ptr("ORDER-1")
+			registry := newFakeRegistry(t, fieldSchedule)
+			s := &FieldScheduleService{repository: registry}
+
+			_, err := s.Cancel(context.Background(), &dto.CancelFieldScheduleRequest{
+				FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+				BookingReference: tt.reference,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Cancel() error = %v, want %v", err, tt.wantErr)
+			}
+			registry.assertTx(t, 0, 1)
+			if got := registry.fieldSchedule.schedules[1].Status; got != tt.status {
+				t.Errorf("status = %d, want it unchanged at %d", got, tt.status)
+			}
+			if len(registry.fieldSchedule.histories) != 0 {
+				t.Errorf("wrote %d histories, want none", len(registry.fieldSchedule.histories))
+			}
+		})
+	}
+}
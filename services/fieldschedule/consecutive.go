@@ -0,0 +1,101 @@
+package services
+
+import (
+	"context"
+	"field-service/common/util"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"sort"
+
+	"gorm.io/gorm"
+)
+
+// summarizeSlots adds the total duration and price of the locked schedules to the response, each slot priced
+// for its own length at its hourly rate. With requireConsecutive the schedules must be on one field and date,
+// each slot starting where the previous ends.
+func (s *FieldScheduleService) summarizeSlots(
+	ctx context.Context,
+	tx *gorm.DB,
+	fieldSchedules []models.FieldSchedule,
+	requireConsecutive bool,
+	response *dto.FieldScheduleHoldResponse,
+) error {
+	details, err := s.repository.GetFieldSchedule().FindAllByIDs(ctx, tx, s.scheduleIDs(fieldSchedules))
+	if err != nil {
+		return err
+	}
+
+	startMinutes := make(map[uint]int, len(details))
+	for _, detail := range details {
+		_, startMinutes[detail.ID], err = util.ParseClock(detail.Time.StartTime)
+		if err != nil {
+			return err
+		}
+	}
+	sort.Slice(details, func(i, j int) bool {
+		if !details[i].Date.Equal(details[j].Date) {
+			return details[i].Date.Before(details[j].Date)
+		}
+		return startMinutes[details[i].ID] < startMinutes[details[j].ID]
+	})
+
+	for i, detail := range details {
+		if requireConsecutive && i > 0 {
+			consecutive, err := isConsecutive(details[i-1], detail)
+			if err != nil {
+				return err
+			}
+			if !consecutive {
+				return errFieldSchedule.ErrFieldScheduleNotConsecutive
+			}
+		}
+
+		duration, err := slotDurationMinute(detail.Time)
+		if err != nil {
+			return err
+		}
+		price, err := slotPrice(detail)
+		if err != nil {
+			return err
+		}
+		response.TotalDurationMinute += duration
+		response.TotalPrice += price
+	}
+	return nil
+}
+
+// isConsecutive tells whether next continues previous on the same field and date. A slot running to midnight
+// ends the day, so nothing can follow it.
+func isConsecutive(previous models.FieldSchedule, next models.FieldSchedule) (bool, error) {
+	if previous.FieldID != next.FieldID || !previous.Date.Equal(next.Date) || previous.Time.CrossesMidnight {
+		return false, nil
+	}
+
+	_, previousEnd, err := util.ParseClock(previous.Time.EndTime)
+	if err != nil {
+		return false, err
+	}
+	_, nextStart, err := util.ParseClock(next.Time.StartTime)
+	if err != nil {
+		return false, err
+	}
+	return previousEnd == nextStart, nil
+}
+
+func slotDurationMinute(slot models.Time) (int, error) {
+	_, startMinute, err := util.ParseClock(slot.StartTime)
+	if err != nil {
+		return 0, err
+	}
+	_, endMinute, err := util.ParseClock(slot.EndTime)
+	if err != nil {
+		return 0, err
+	}
+
+	duration := endMinute - startMinute
+	if duration <= 0 {
+		duration += util.MinutesPerDay
+	}
+	return duration, nil
+}
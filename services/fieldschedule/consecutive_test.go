@@ -0,0 +1,108 @@
+package services
+
+import (
+	"field-service/domain/models"
+	"testing"
+	"time"
+)
+
+func TestIsConsecutive(t *testing.T) {
+	day := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	slot := func(fieldID uint, date time.Time, start, end string, crossesMidnight bool) models.FieldSchedule {
+		return models.FieldSchedule{
+			FieldID: fieldID,
+			Date:    date,
+			Time:    models.Time{StartTime: start, EndTime: end, CrossesMidnight: crossesMidnight},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		previous models.FieldSchedule
+		next     models.FieldSchedule
+		want     bool
+	}{
+		{
+			name:     "next starts where previous ends",
+			previous: slot(1, day, "18:00:00", "19:00:00", false),
+			next:     slot(1, day, "19:00:00", "20:00:00", false),
+			want:     true,
+		},
+		{
+			name:     "gap between slots",
+			previous: slot(1, day, "18:00:00", "19:00:00", false),
+			next:     slot(1, day, "19:30:00", "20:30:00", false),
+		},
+		{
+			name:     "overlapping slots",
+			previous: slot(1, day, "18:00:00", "19:00:00", false),
+			next:     slot(1, day, "18:30:00", "19:30:00", false),
+		},
+		{
+			name:     "another field",
+			previous: slot(1, day, "18:00:00", "19:00:00", false),
+			next:     slot(2, day, "19:00:00", "20:00:00", false),
+		},
+		{
+			name:     "another date",
+			previous: slot(1, day, "18:00:00", "19:00:00", false),
+			next:     slot(1, day.AddDate(0, 0, 1), "19:00:00", "20:00:00", false),
+		},
+		{
+			name:     "slot ending at midnight closes the day",
+			previous: slot(1, day, "23:00:00", "00:00:00", true),
+			next:     slot(1, day, "00:00:00", "01:00:00", false),
+		},
+		{
+			name:     "slot ending at midnight is not followed by the next day's first slot",
+			previous: slot(1, day, "23:00:00", "00:00:00", true),
+			next:     slot(1, day.AddDate(0, 0, 1), "00:00:00", "01:00:00", false),
+		},
+		{
+			name:     "slot before the last one of the day",
+			previous: slot(1, day, "22:00:00", "23:00:00", false),
+			next:     slot(1, day, "23:00:00", "00:00:00", true),
+			want:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := isConsecutive(tt.previous, tt.next)
+			if err != nil {
+				t.Fatalf("isConsecutive() unexpected error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("isConsecutive() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlotDurationMinute(t *testing.T) {
+	tests := []struct {
+		name    string
+		slot    models.Time
+		want    int
+		wantErr bool
+	}{
+		{name: "one hour", slot: models.Time{StartTime: "18:00:00", EndTime: "19:00:00"}, want: 60},
+		{name: "ninety minutes", slot: models.Time{StartTime: "18:00:00", EndTime: "19:30:00"}, want: 90},
+		{name: "ends at midnight", slot: models.Time{StartTime: "23:00:00", EndTime: "00:00:00"}, want: 60},
+		{name: "crosses midnight", slot: models.Time{StartTime: "23:30:00", EndTime: "00:30:00"}, want: 60},
+		{name: "whole day", slot: models.Time{StartTime: "00:00:00", EndTime: "00:00:00"}, want: 1440},
+		{name: "malformed time", slot: models.Time{StartTime: "late", EndTime: "00:30:00"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slotDurationMinute(tt.slot)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("slotDurationMinute() error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("slotDurationMinute() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
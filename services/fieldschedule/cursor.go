@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"field-service/common/util"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	venueService "field-service/services/venue"
+	"fmt"
+	"time"
+)
+
+// getAllWithCursor pages through the schedules by keyset instead of offset, so deep pages cost the same as the
+// first one. The total is only counted on request because it is what makes large listings slow.
+func (s *FieldScheduleService) getAllWithCursor(
+	ctx context.Context,
+	param *dto.FieldScheduleRequestParam,
+) (*util.PaginationResult, error) {
+	cursor, err := decodeCursor(*param.Cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldSchedules, err := s.repository.GetFieldSchedule().FindAllWithCursor(ctx, param, cursor, param.Limit+1)
+	if err != nil {
+		return nil, err
+	}
+
+	backward := cursor != nil && cursor.Backward
+	hasMore := len(fieldSchedules) > param.Limit
+	if hasMore {
+		if backward {
+			fieldSchedules = fieldSchedules[1:]
+		} else {
+			fieldSchedules = fieldSchedules[:param.Limit]
+		}
+	}
+
+	var nextCursor, prevCursor *string
+	if len(fieldSchedules) > 0 {
+		if backward || hasMore {
+			nextCursor = encodeCursor(fieldSchedules[len(fieldSchedules)-1], false)
+		}
+		if (cursor != nil && !backward) || (backward && hasMore) {
+			prevCursor = encodeCursor(fieldSchedules[0], true)
+		}
+	}
+
+	var total *int64
+	if param.WithTotal {
+		count, err := s.repository.GetFieldSchedule().Count(ctx, param)
+		if err != nil {
+			return nil, err
+		}
+		total = &count
+	}
+
+	result := util.GenerateCursorPagination(util.CursorPaginationParam{
+		Count:      total,
+		Limit:      param.Limit,
+		NextCursor: nextCursor,
+		PrevCursor: prevCursor,
+		Data:       scheduleResponses(ctx, fieldSchedules),
+	})
+	return &result, nil
+}
+
+func scheduleResponses(ctx context.Context, fieldSchedules []models.FieldSchedule) []dto.FieldScheduleResponse {
+	fieldScheduleResults := make([]dto.FieldScheduleResponse, 0, len(fieldSchedules))
+	for _, schedule := range fieldSchedules {
+		fieldScheduleResults = append(fieldScheduleResults, scheduleResponse(ctx, schedule))
+	}
+	return fieldScheduleResults
+}
+
+// scheduleResponse expects the schedule loaded with its time and its field's venue.
+func scheduleResponse(ctx context.Context, schedule models.FieldSchedule) dto.FieldScheduleResponse {
+	return dto.FieldScheduleResponse{
+		UUID:         schedule.UUID,
+		FieldName:    schedule.Field.Name,
+		Date:         schedule.Date.Format(time.DateOnly),
+		PricePerHour: effectivePrice(schedule),
+		Status:       schedule.Status.GetStatusString(),
+		Time:         fmt.Sprintf("%s - %s", schedule.Time.StartTime, schedule.Time.EndTime),
+		Booking:      bookingDetail(ctx, schedule),
+		CreatedAt:    venueService.InLocation(schedule.CreatedAt, venueService.FieldLocation(&schedule.Field)),
+		UpdatedAt:    venueService.InLocation(schedule.UpdatedAt, venueService.FieldLocation(&schedule.Field)),
+	}
+}
+
+func encodeCursor(fieldSchedule models.FieldSchedule, backward bool) *string {
+	payload, _ := json.Marshal(dto.FieldScheduleCursor{
+		Date:      fieldSchedule.Date.Format(time.DateOnly),
+		StartTime: fieldSchedule.Time.StartTime,
+		ID:        fieldSchedule.ID,
+		Backward:  backward,
+	})
+	cursor := base64.RawURLEncoding.EncodeToString(payload)
+	return &cursor
+}
+
+// decodeCursor returns nil for the empty cursor that asks for the first page.
+func decodeCursor(value string) (*dto.FieldScheduleCursor, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidCursor
+	}
+
+	var cursor dto.FieldScheduleCursor
+	err = json.Unmarshal(payload, &cursor)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidCursor
+	}
+	if _, err = time.Parse(time.DateOnly, cursor.Date); err != nil {
+		return nil, errFieldSchedule.ErrInvalidCursor
+	}
+	if _, err = time.Parse(time.TimeOnly, cursor.StartTime); err != nil {
+		return nil, errFieldSchedule.ErrInvalidCursor
+	}
+	return &cursor, nil
+}
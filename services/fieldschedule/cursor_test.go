@@ -0,0 +1,69 @@
+package services
+
+import (
+	"encoding/base64"
+	"errors"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"testing"
+	"time"
+)
+
+func TestCursorRoundTrip(t *testing.T) {
+	fieldSchedule := models.FieldSchedule{
+		ID:   42,
+		Date: time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC),
+		Time: models.Time{StartTime: "19:00:00"},
+	}
+
+	for _, backward := range []bool{false, true} {
+		cursor, err := decodeCursor(*encodeCursor(fieldSchedule, backward))
+		if err != nil {
+			t.Fatalf("decodeCursor() unexpected error = %v", err)
+		}
+		want := dto.FieldScheduleCursor{Date: "2024-06-01", StartTime: "19:00:00", ID: 42, Backward: backward}
+		if *cursor != want {
+			t.Errorf("decodeCursor() = %+v, want %+v", *cursor, want)
+		}
+	}
+}
+
+func TestDecodeCursor(t *testing.T) {
+	encode := func(payload string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(payload))
+	}
+
+	tests := []struct {
+		name    string
+		value   string
+		wantNil bool
+		wantErr error
+	}{
+		{name: "empty cursor asks for the first page", value: "", wantNil: true},
+		{name: "valid cursor", value: encode(`{"d":"2024-06-01","t":"19:00:00","i":42}`)},
+		{name: "not base64", value: "not a cursor!", wantErr: errFieldSchedule.ErrInvalidCursor},
+		{name: "padded base64", value: base64.URLEncoding.EncodeToString([]byte(`{"d":"2024-06-01"}`)),
+			wantErr: errFieldSchedule.ErrInvalidCursor},
+		{name: "not json", value: encode("2024-06-01,19:00:00,42"), wantErr: errFieldSchedule.ErrInvalidCursor},
+		{name: "wrong field type", value: encode(`{"d":"2024-06-01","t":"19:00:00","i":"42"}`),
+			wantErr: errFieldSchedule.ErrInvalidCursor},
+		{name: "tampered date", value: encode(`{"d":"2024-06-01' OR 1=1","t":"19:00:00","i":42}`),
+			wantErr: errFieldSchedule.ErrInvalidCursor},
+		{name: "tampered start time", value: encode(`{"d":"2024-06-01","t":"7pm","i":42}`),
+			wantErr: errFieldSchedule.ErrInvalidCursor},
+		{name: "missing date", value: encode(`{"t":"19:00:00","i":42}`), wantErr: errFieldSchedule.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cursor, err := decodeCursor(tt.value)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("decodeCursor() error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && (cursor == nil) != tt.wantNil {
+				t.Errorf("decodeCursor() = %+v, want nil %v", cursor, tt.wantNil)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"field-service/constants"
+	"field-service/domain/models"
+	"field-service/repositories"
+	auditLogRepo "field-service/repositories/auditlog"
+	fieldScheduleRepo "field-service/repositories/fieldschedule"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// fakeConnector hands out connections that only support transactions and counts how they end, so a test can
+// tell a committed transition from one that rolled back without a database.
+type fakeConnector struct {
+	commits   int
+	rollbacks int
+}
+
+func (c *fakeConnector) Connect(context.Context) (driver.Conn, error) {
+	return &fakeConn{connector: c}, nil
+}
+
+func (c *fakeConnector) Driver() driver.Driver {
+	return fakeDriver{}
+}
+
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return nil, errors.New("fake driver: use a connector")
+}
+
+type fakeConn struct {
+	connector *fakeConnector
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("fake driver: no statements")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return &fakeTx{connector: c.connector}, nil
+}
+
+type fakeTx struct {
+	connector *fakeConnector
+}
+
+func (t *fakeTx) Commit() error {
+	t.connector.commits++
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.connector.rollbacks++
+	return nil
+}
+
+// fakeRegistry serves the schedule and audit log repositories from memory; any other repository panics, which
+// flags a transition reaching for something it should not.
+type fakeRegistry struct {
+	repositories.IRepositoryRegistry
+	connector     *fakeConnector
+	db            *gorm.DB
+	fieldSchedule *fakeFieldScheduleRepository
+	auditLog      *fakeAuditLogRepository
+}
+
+func newFakeRegistry(t *testing.T, fieldSchedules ...models.FieldSchedule) *fakeRegistry {
+	t.Helper()
+
+	connector := &fakeConnector{}
+	sqlDB := sql.OpenDB(connector)
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{DisableAutomaticPing: true})
+	if err != nil {
+		t.Fatalf("gorm.Open() error = %v", err)
+	}
+
+	fieldSchedule := &fakeFieldScheduleRepository{t: t, schedules: make(map[uint]*models.FieldSchedule)}
+	for i := range fieldSchedules {
+		fieldSchedule.schedules[fieldSchedules[i].ID] = &fieldSchedules[i]
+		fieldSchedule.order = append(fieldSchedule.order, fieldSchedules[i].ID)
+	}
+
+	return &fakeRegistry{
+		connector:     connector,
+		db:            db,
+		fieldSchedule: fieldSchedule,
+		auditLog:      &fakeAuditLogRepository{t: t},
+	}
+}
+
+func (r *fakeRegistry) GetTx() *gorm.DB {
+	return r.db
+}
+
+func (r *fakeRegistry) GetFieldSchedule() fieldScheduleRepo.IFieldScheduleRepository {
+	return r.fieldSchedule
+}
+
+func (r *fakeRegistry) GetAuditLog() auditLogRepo.IAuditLogRepository {
+	return r.auditLog
+}
+
+// assertTx checks how many transactions committed and rolled back.
+func (r *fakeRegistry) assertTx(t *testing.T, commits int, rollbacks int) {
+	t.Helper()
+	if r.connector.commits != commits || r.connector.rollbacks != rollbacks {
+		t.Errorf("transactions committed %d and rolled back %d, want %d and %d",
+			r.connector.commits, r.connector.rollbacks, commits, rollbacks)
+	}
+}
+
+// inTx tells whether the statement runs inside a transaction rather than on the pool.
+func inTx(tx *gorm.DB) bool {
+	if tx == nil {
+		return false
+	}
+	_, ok := tx.Statement.ConnPool.(*sql.Tx)
+	return ok
+}
+
+type fakeFieldScheduleRepository struct {
+	fieldScheduleRepo.IFieldScheduleRepository
+	t         *testing.T
+	schedules map[uint]*models.FieldSchedule
+	order     []uint
+	locked    [][]string
+	histories []models.FieldScheduleHistory
+}
+
+func (f *fakeFieldScheduleRepository) FindAllByUUIDsForUpdate(
+	_ context.Context,
+	tx *gorm.DB,
+	uuids []string,
+) ([]models.FieldSchedule, error) {
+	if !inTx(tx) {
+		f.t.Error("FindAllByUUIDsForUpdate() called outside a transaction")
+	}
+
+	wanted := make(map[string]bool, len(uuids))
+	for _, item := range uuids {
+		wanted[item] = true
+	}
+	var locked []string
+	var fieldSchedules []models.FieldSchedule
+	for _, id := range f.order {
+		if fieldSchedule := f.schedules[id]; wanted[fieldSchedule.UUID.String()] {
+			locked = append(locked, fieldSchedule.UUID.String())
+			fieldSchedules = append(fieldSchedules, *fieldSchedule)
+		}
+	}
+	f.locked = append(f.locked, locked)
+	return fieldSchedules, nil
+}
+
+func (f *fakeFieldScheduleRepository) FindAllByIDs(
+	_ context.Context,
+	_ *gorm.DB,
+	ids []uint,
+) ([]models.FieldSchedule, error) {
+	fieldSchedules := make([]models.FieldSchedule, 0, len(ids))
+	for _, id := range ids {
+		if fieldSchedule, ok := f.schedules[id]; ok {
+			fieldSchedules = append(fieldSchedules, *fieldSchedule)
+		}
+	}
+	return fieldSchedules, nil
+}
+
+// UpdateStatusByIDs follows the repository: an available slot loses its booking, otherwise the values in booking
+// are written over the ones the slot carries.
+func (f *fakeFieldScheduleRepository) UpdateStatusByIDs(
+	_ context.Context,
+	tx *gorm.DB,
+	ids []uint,
+	status constants.FieldScheduleStatus,
+	holdExpiresAt *time.Time,
+	booking *models.Booking,
+) error {
+	if !inTx(tx) {
+		f.t.Error("UpdateStatusByIDs() called outside a transaction")
+	}
+
+	now := time.Now()
+	for _, id := range ids {
+		fieldSchedule := f.schedules[id]
+		fieldSchedule.Status = status
+		fieldSchedule.HoldExpiresAt = holdExpiresAt
+		if status == constants.Available {
+			fieldSchedule.Booking = models.Booking{}
+			continue
+		}
+		if booking != nil && booking.BookingReference != nil {
+			fieldSchedule.BookingReference = booking.BookingReference
+		}
+		if booking != nil && booking.CustomerUUID != nil {
+			fieldSchedule.CustomerUUID = booking.CustomerUUID
+		}
+		if status == constants.Booked {
+			fieldSchedule.BookedAt = &now
+		}
+	}
+	return nil
+}
+
+func (f *fakeFieldScheduleRepository) CreateHistories(
+	_ context.Context,
+	tx *gorm.DB,
+	histories []models.FieldScheduleHistory,
+) error {
+	if !inTx(tx) {
+		f.t.Error("CreateHistories() called outside a transaction")
+	}
+	f.histories = append(f.histories, histories...)
+	return nil
+}
+
+type fakeAuditLogRepository struct {
+	auditLogRepo.IAuditLogRepository
+	t         *testing.T
+	err       error
+	auditLogs []models.AuditLog
+}
+
+func (f *fakeAuditLogRepository) Create(_ context.Context, tx *gorm.DB, auditLogs []models.AuditLog) error {
+	if !inTx(tx) {
+		f.t.Error("audit log written outside a transaction")
+	}
+	if f.err != nil {
+		return f.err
+	}
+	f.auditLogs = append(f.auditLogs, auditLogs...)
+	return nil
+}
+
+// newTestSchedule returns a one hour slot from 10:00 on the day daysAhead from today, priced at price.
+func newTestSchedule(id uint, status constants.FieldScheduleStatus, daysAhead int, price int) models.FieldSchedule {
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	return models.FieldSchedule{
+		ID:     id,
+		UUID:   uuid.New(),
+		Date:   today.AddDate(0, 0, daysAhead),
+		Status: status,
+		Price:  &price,
+		Time:   models.Time{StartTime: "10:00:00", EndTime: "11:00:00"},
+	}
+}
+
+func ptr[T any](value T) *T {
+	return &value
+}
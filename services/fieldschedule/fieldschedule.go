@@ -3,15 +3,20 @@ package services
 import (
 	"context"
 	"field-service/common/util"
+	"field-service/config"
 	"field-service/constants"
 	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errTime "field-service/constants/error/time"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"field-service/repositories"
+	venueService "field-service/services/venue"
 	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
 )
 
 type FieldScheduleService struct {
@@ -21,15 +26,29 @@ type FieldScheduleService struct {
 type IFieldScheduleService interface {
 	GetAllWithPagination(context.Context, *dto.FieldScheduleRequestParam) (*util.PaginationResult, error)
 	GetAllByFieldIDAndDate(context.Context, string, string) ([]dto.FieldScheduleBookingResponse, error)
+	SearchAvailability(context.Context, *dto.FieldAvailabilityRequestParam) ([]dto.FieldAvailabilityResponse, error)
 	GetByUUID(context.Context, string) (*dto.FieldScheduleResponse, error)
-	GenerateScheduleForOneMonth(context.Context, *dto.GenerateFieldScheduleFromOneMonthRequest) error
-	Create(context.Context, *dto.FieldScheduleRequest) error
+	GetHistory(context.Context, string) ([]dto.FieldScheduleHistoryResponse, error)
+	GenerateScheduleForOneMonth(context.Context, *dto.GenerateFieldScheduleFromOneMonthRequest) (*dto.GenerateFieldScheduleResponse, error)
+	GenerateSchedule(context.Context, *dto.GenerateFieldScheduleRequest) (*dto.GenerateFieldScheduleResponse, error)
+	GenerateFromTemplates(context.Context, string) (*dto.GenerateFieldScheduleResponse, error)
+	GenerateFromTemplatesForAllFields(context.Context) (*dto.GenerateFieldScheduleResponse, error)
+	ExpireHolds(context.Context) (int64, error)
+	PurgePastUnbooked(context.Context) (int64, error)
+	Create(context.Context, *dto.FieldScheduleRequest) (*dto.GenerateFieldScheduleResponse, error)
 
 	Update(context.Context, string, *dto.UpdateFieldScheduleRequest) (*dto.FieldScheduleResponse, error)
+	UpdatePrice(context.Context, string, *dto.UpdateFieldSchedulePriceRequest) (*dto.FieldScheduleResponse, error)
 
-	UpdateStatus(context.Context, *dto.UpdateStatusScheduleRquest) error
+	UpdateStatus(context.Context, *dto.UpdateStatusScheduleRquest) (*dto.FieldScheduleHoldResponse, error)
+	Hold(context.Context, *dto.HoldFieldScheduleRequest) (*dto.FieldScheduleHoldResponse, error)
+	Confirm(context.Context, *dto.UpdateStatusScheduleRquest) (*dto.FieldScheduleHoldResponse, error)
+	Release(context.Context, *dto.UpdateStatusScheduleRquest) (*dto.FieldScheduleHoldResponse, error)
+	Cancel(context.Context, *dto.CancelFieldScheduleRequest) (*dto.FieldScheduleHoldResponse, error)
+	Reschedule(context.Context, *dto.RescheduleFieldScheduleRequest) (*dto.FieldScheduleRescheduleResponse, error)
 
-	Delete(context.Context, string) error
+	Restore(context.Context, string) (*dto.FieldScheduleResponse, error)
+	Delete(context.Context, string, *dto.DeleteFieldScheduleRequestParam) error
 }
 
 func NewFieldScheduleService(repository repositories.IRepositoryRegistry) IFieldScheduleService {
@@ -37,29 +56,25 @@ func NewFieldScheduleService(repository repositories.IRepositoryRegistry) IField
 }
 
 func (s *FieldScheduleService) GetAllWithPagination(ctx context.Context, param *dto.FieldScheduleRequestParam) (*util.PaginationResult, error) {
-	fieldSchedules, total, err := s.repository.GetFieldSchedule().FindAllWithPagination(ctx, param)
+	err := validateRequestParam(param)
 	if err != nil {
 		return nil, err
 	}
-	fieldScheduleResults := make([]dto.FieldScheduleResponse, 0, len(fieldSchedules))
-	for _, schedule := range fieldSchedules {
-		fieldScheduleResults = append(fieldScheduleResults, dto.FieldScheduleResponse{
-			UUID:         schedule.UUID,
-			FieldName:    schedule.Field.Name,
-			Date:         schedule.Date.Format("2006-01-02"),
-			PricePerHour: schedule.Field.PricePerHour,
-			Status:       schedule.Status.GetStatusString(),
-			Time:         fmt.Sprintf("%s - %s", schedule.Time.StartTime, schedule.Time.EndTime),
-			CreatedAt:    schedule.CreatedAt,
-			UpdatedAt:    schedule.UpdatedAt,
-		})
+
+	if param.Cursor != nil {
+		return s.getAllWithCursor(ctx, param)
+	}
+
+	fieldSchedules, total, err := s.repository.GetFieldSchedule().FindAllWithPagination(ctx, param)
+	if err != nil {
+		return nil, err
 	}
 
 	pagination := &util.PaginationParam{
 		Count: total,
 		Page:  param.Page,
 		Limit: param.Limit,
-		Data:  fieldScheduleResults,
+		Data:  scheduleResponses(ctx, fieldSchedules),
 	}
 
 	respone := util.GeneratePagination(*pagination)
@@ -69,22 +84,30 @@ func (s *FieldScheduleService) GetAllWithPagination(ctx context.Context, param *
 
 // GetAllByFieldIDAndDate implements IFieldScheduleRepository.
 func (s *FieldScheduleService) GetAllByFieldIDAndDate(ctx context.Context, uuid string, date string) ([]dto.FieldScheduleBookingResponse, error) {
-	field, err := s.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	field, err := s.repository.GetField().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
 	fieldSchedules, err := s.repository.GetFieldSchedule().FindAllByFieldIDAndDate(ctx, int(field.ID), date)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
 	fieldScheduleResults := make([]dto.FieldScheduleBookingResponse, 0, len(fieldSchedules))
 	for _, fieldSchedule := range fieldSchedules {
-		pricePerHour := float64(fieldSchedule.Field.PricePerHour)
+		status := fieldSchedule.Status.GetStatusString()
+		if s.isAvailable(fieldSchedule, now) {
+			status = constants.AvailableString
+		}
+		pricePerHour := float64(effectivePrice(fieldSchedule))
 		startTime, _ := time.Parse("15:04:05", fieldSchedule.Time.StartTime)
 		endTime, _ := time.Parse("15:04:05", fieldSchedule.Time.EndTime)
 		fieldScheduleResults = append(fieldScheduleResults, dto.FieldScheduleBookingResponse{
 			UUID:         fieldSchedule.UUID,
 			PricePerHour: util.RupiahFormat(&pricePerHour),
 			Date:         s.convertMonthName(fieldSchedule.Date.Format("2006-01-02")),
-			Status:       fieldSchedule.Status.GetStatusString(),
+			Status:       status,
 			Time:         fmt.Sprintf("%s - %s", startTime.Format("15:04"), endTime.Format("15:04")),
 		})
 	}
@@ -99,14 +122,7 @@ func (s *FieldScheduleService) GetByUUID(ctx context.Context, uuid string) (*dto
 		return nil, err
 	}
 
-	response := dto.FieldScheduleResponse{
-		UUID:         fieldSchedule.UUID,
-		FieldName:    fieldSchedule.Field.Name,
-		PricePerHour: fieldSchedule.Field.PricePerHour,
-		Date:         fieldSchedule.Date.Format(time.DateOnly),
-		Status:       fieldSchedule.Status.GetStatusString(),
-		Time:         fmt.Sprintf("%s - %s", fieldSchedule.Time.StartTime, fieldSchedule.Time.EndTime),
-	}
+	response := scheduleResponse(ctx, *fieldSchedule)
 	return &response, nil
 }
 
@@ -114,37 +130,41 @@ func (s *FieldScheduleService) GetByUUID(ctx context.Context, uuid string) (*dto
 func (f *FieldScheduleService) GenerateScheduleForOneMonth(
 	ctx context.Context,
 	request *dto.GenerateFieldScheduleFromOneMonthRequest,
-) error {
-	field, err := f.repository.GetField().FindByUUID(ctx, request.FieldID)
+) (*dto.GenerateFieldScheduleResponse, error) {
+	return f.GenerateSchedule(ctx, &dto.GenerateFieldScheduleRequest{
+		FieldID:      request.FieldID,
+		NumberOfDays: constants.DefaultGenerateScheduleDays,
+	})
+}
+
+// GenerateSchedule implements IFieldScheduleService.
+func (s *FieldScheduleService) GenerateSchedule(
+	ctx context.Context,
+	request *dto.GenerateFieldScheduleRequest,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	field, err := s.repository.GetField().FindByUUID(ctx, request.FieldID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	times, err := f.repository.GetTime().FindAll(ctx)
+	err = venueService.AuthorizeField(ctx, field)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	numberOfDays := 30
-	fieldSchedules := make([]models.FieldSchedule, 0, numberOfDays)
-	now := time.Now().Add(time.Duration(1) * 24 * time.Hour)
-	for i := 0; i < numberOfDays; i++ {
-		currentDate := now.AddDate(0, 0, i)
-		for _, item := range times {
-			schedule, err := f.repository.GetFieldSchedule().FindByDateAndTimeID(
-				ctx,
-				currentDate.Format(time.DateOnly),
-				int(item.ID),
-				int(field.ID),
-			)
-			if err != nil {
-				return err
-			}
+	startDate, endDate, err := s.resolveGenerateWindow(request, venueService.FieldLocation(field))
+	if err != nil {
+		return nil, err
+	}
 
-			if schedule != nil {
-				return errFieldSchedule.ErrFieldScheduleIsExist
-			}
+	timesByWeekday, err := s.resolveWeekdayTimes(ctx, field, request.Weekdays)
+	if err != nil {
+		return nil, err
+	}
 
+	fieldSchedules := make([]models.FieldSchedule, 0)
+	for currentDate := startDate; !currentDate.After(endDate); currentDate = currentDate.AddDate(0, 0, 1) {
+		for _, item := range timesByWeekday[currentDate.Weekday()] {
 			fieldSchedules = append(fieldSchedules, models.FieldSchedule{
 				UUID:    uuid.New(),
 				FieldID: field.ID,
@@ -155,38 +175,135 @@ func (f *FieldScheduleService) GenerateScheduleForOneMonth(
 		}
 	}
 
-	err = f.repository.GetFieldSchedule().Create(ctx, fieldSchedules)
+	return s.createSchedules(ctx, field, fieldSchedules)
+}
+
+// GenerateFromTemplates implements IFieldScheduleService. It keeps the field's schedule materialized from
+// its active templates up to the configured horizon; fields without templates are left untouched.
+func (s *FieldScheduleService) GenerateFromTemplates(
+	ctx context.Context,
+	fieldUUID string,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	field, err := s.repository.GetField().FindByUUID(ctx, fieldUUID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	scheduleTemplates, err := s.repository.GetScheduleTemplate().FindActiveByFieldID(ctx, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	weekdayOrder := make([]string, 0, 7)
+	timeIDsByWeekday := make(map[string][]string, 7)
+	seen := make(map[string]bool)
+	for _, scheduleTemplate := range scheduleTemplates {
+		for _, weekday := range scheduleTemplate.Weekdays {
+			for _, item := range scheduleTemplate.Times {
+				if !item.IsActive {
+					continue
+				}
+				key := fmt.Sprintf("%s:%s", weekday, item.UUID)
+				if seen[key] {
+					continue
+				}
+				seen[key] = true
+				if _, ok := timeIDsByWeekday[weekday]; !ok {
+					weekdayOrder = append(weekdayOrder, weekday)
+				}
+				timeIDsByWeekday[weekday] = append(timeIDsByWeekday[weekday], item.UUID.String())
+			}
+		}
+	}
+
+	if len(weekdayOrder) == 0 {
+		return &dto.GenerateFieldScheduleResponse{}, nil
+	}
+
+	weekdays := make([]dto.WeekdayScheduleRequest, 0, len(weekdayOrder))
+	for _, weekday := range weekdayOrder {
+		weekdays = append(weekdays, dto.WeekdayScheduleRequest{
+			Weekday: weekday,
+			TimeIDs: timeIDsByWeekday[weekday],
+		})
+	}
+
+	horizonDays := config.Config.ScheduleHorizonDays
+	if horizonDays <= 0 {
+		horizonDays = constants.DefaultScheduleHorizonDays
+	}
+
+	return s.GenerateSchedule(ctx, &dto.GenerateFieldScheduleRequest{
+		FieldID:      fieldUUID,
+		NumberOfDays: horizonDays,
+		Weekdays:     weekdays,
+	})
+}
+
+// GenerateFromTemplatesForAllFields implements IFieldScheduleService. A field that fails is logged and
+// skipped so one broken template does not stop the others from being extended.
+func (s *FieldScheduleService) GenerateFromTemplatesForAllFields(ctx context.Context) (*dto.GenerateFieldScheduleResponse, error) {
+	fields, err := s.repository.GetField().FindAllWithoutPagination(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	total := &dto.GenerateFieldScheduleResponse{}
+	for _, field := range fields {
+		result, err := s.GenerateFromTemplates(ctx, field.UUID.String())
+		if err != nil {
+			logrus.Errorf("failed to generate schedule for field %s: %v", field.UUID, err)
+			continue
+		}
+		total.Created += result.Created
+		total.Skipped += result.Skipped
+	}
+	return total, nil
+}
+
+// ExpireHolds implements IFieldScheduleService.
+func (s *FieldScheduleService) ExpireHolds(ctx context.Context) (int64, error) {
+	return s.repository.GetFieldSchedule().ReleaseExpiredHolds(ctx)
+}
+
+// PurgePastUnbooked implements IFieldScheduleService. Days are counted in each field's own timezone so no field
+// loses slots for a day that has not ended locally. The repository resolves it per row in the same order as
+// FieldLocation; the default location only stands in for fields whose field and venue have no timezone.
+func (s *FieldScheduleService) PurgePastUnbooked(ctx context.Context) (int64, error) {
+	return s.repository.GetFieldSchedule().DeletePastUnbooked(ctx, venueService.DefaultLocation())
 }
 
 // Create implements IFieldScheduleRepository.
-func (s *FieldScheduleService) Create(ctx context.Context, request *dto.FieldScheduleRequest) error {
-	field, err := s.repository.GetFieldSchedule().FindByUUID(ctx, request.FieldID)
+func (s *FieldScheduleService) Create(
+	ctx context.Context,
+	request *dto.FieldScheduleRequest,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	field, err := s.repository.GetField().FindByUUID(ctx, request.FieldID)
+	if err != nil {
+		return nil, err
+	}
 
+	err = venueService.AuthorizeField(ctx, field)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	dateParsed, err := time.ParseInLocation(time.DateOnly, request.Date, venueService.FieldLocation(field))
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidDate
 	}
 
 	fieldSchedules := make([]models.FieldSchedule, 0, len(request.TimeIDs))
-	dateParsed, _ := time.Parse(time.DateOnly, request.Date)
 	for _, timeID := range request.TimeIDs {
-		scheduleTime, err := s.repository.GetTime().FindByUUID(ctx, timeID)
+		scheduleTime, err := s.findFieldTime(ctx, field, timeID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		schedule, err := s.repository.GetFieldSchedule().FindByDateAndTimeID(ctx, request.Date, int(scheduleTime.ID), int(field.ID))
-		if err != nil {
-			return err
+		if !scheduleTime.IsActive {
+			return nil, errTime.ErrTimeInactive
 		}
 
-		if schedule != nil {
-			return errFieldSchedule.ErrFieldScheduleIsExist
-		}
 		fieldSchedules = append(fieldSchedules, models.FieldSchedule{
 			UUID:    uuid.New(),
 			FieldID: field.ID,
@@ -195,105 +312,740 @@ func (s *FieldScheduleService) Create(ctx context.Context, request *dto.FieldSch
 			Status:  constants.Available,
 		})
 	}
-	err = s.repository.GetFieldSchedule().Create(ctx, fieldSchedules)
+
+	return s.createSchedules(ctx, field, fieldSchedules)
+}
+
+// UpdateStatus implements IFieldScheduleRepository.
+func (s *FieldScheduleService) UpdateStatus(
+	ctx context.Context,
+	request *dto.UpdateStatusScheduleRquest,
+) (*dto.FieldScheduleHoldResponse, error) {
+	booking, err := newBooking(request.BookingReference, request.CustomerUUID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	now := time.Now()
+	var response *dto.FieldScheduleHoldResponse
+	err = s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		fieldSchedules, txErr := s.lockSchedules(ctx, tx, request.FieldScheduleIDs)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.checkAvailability(fieldSchedules, now)
+		if txErr != nil {
+			return txErr
+		}
+
+		response = s.toHoldResponse(fieldSchedules, constants.Booked, nil)
+		txErr = s.summarizeSlots(ctx, tx, fieldSchedules, request.RequireConsecutive, response)
+		if txErr != nil {
+			return txErr
+		}
 
+		_, txErr = s.changeStatus(ctx, tx, fieldSchedules, constants.Booked, constants.ChangeBook, nil, booking)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
 }
 
-// UpdateStatus implements IFieldScheduleRepository.
-func (s *FieldScheduleService) UpdateStatus(ctx context.Context, request *dto.UpdateStatusScheduleRquest) error {
-	for _, item := range request.FieldScheduleIDs {
-		_, err := s.repository.GetFieldSchedule().FindByUUID(ctx, item)
-		if err != nil {
-			return err
+// Hold implements IFieldScheduleService.
+func (s *FieldScheduleService) Hold(
+	ctx context.Context,
+	request *dto.HoldFieldScheduleRequest,
+) (*dto.FieldScheduleHoldResponse, error) {
+	holdMinutes := request.HoldMinutes
+	if holdMinutes <= 0 {
+		holdMinutes = config.Config.HoldExpirationMinute
+	}
+	if holdMinutes <= 0 {
+		holdMinutes = constants.DefaultHoldExpirationMinute
+	}
+	if holdMinutes > constants.MaxHoldExpirationMinute {
+		holdMinutes = constants.MaxHoldExpirationMinute
+	}
+
+	booking, err := newBooking(request.BookingReference, request.CustomerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	holdExpiresAt := now.Add(time.Duration(holdMinutes) * time.Minute)
+	var response *dto.FieldScheduleHoldResponse
+	err = s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		fieldSchedules, txErr := s.lockSchedules(ctx, tx, request.FieldScheduleIDs)
+		if txErr != nil {
+			return txErr
 		}
-		err = s.repository.GetFieldSchedule().UpdateStatus(ctx, constants.Booked, item)
-		if err != nil {
-			return err
+
+		txErr = s.checkAvailability(fieldSchedules, now)
+		if txErr != nil {
+			return txErr
+		}
+
+		response = s.toHoldResponse(fieldSchedules, constants.Held, &holdExpiresAt)
+		txErr = s.summarizeSlots(ctx, tx, fieldSchedules, request.RequireConsecutive, response)
+		if txErr != nil {
+			return txErr
+		}
+
+		_, txErr = s.changeStatus(ctx, tx, fieldSchedules, constants.Held, constants.ChangeHold, &holdExpiresAt, booking)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Confirm implements IFieldScheduleService.
+func (s *FieldScheduleService) Confirm(
+	ctx context.Context,
+	request *dto.UpdateStatusScheduleRquest,
+) (*dto.FieldScheduleHoldResponse, error) {
+	booking, err := newBooking(request.BookingReference, request.CustomerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var response *dto.FieldScheduleHoldResponse
+	err = s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		fieldSchedules, txErr := s.lockSchedules(ctx, tx, request.FieldScheduleIDs)
+		if txErr != nil {
+			return txErr
+		}
+
+		for _, fieldSchedule := range fieldSchedules {
+			if fieldSchedule.Status != constants.Held {
+				return errFieldSchedule.ErrFieldScheduleNotHeld
+			}
+			if s.isHoldExpired(fieldSchedule, now) {
+				return errFieldSchedule.ErrFieldScheduleHoldExpired
+			}
+			txErr = checkHoldReference(fieldSchedule, request.BookingReference)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		response = s.toHoldResponse(fieldSchedules, constants.Booked, nil)
+		txErr = s.summarizeSlots(ctx, tx, fieldSchedules, request.RequireConsecutive, response)
+		if txErr != nil {
+			return txErr
+		}
+
+		_, txErr = s.changeStatus(ctx, tx, fieldSchedules, constants.Booked, constants.ChangeConfirm, nil, booking)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// Release implements IFieldScheduleService.
+func (s *FieldScheduleService) Release(
+	ctx context.Context,
+	request *dto.UpdateStatusScheduleRquest,
+) (*dto.FieldScheduleHoldResponse, error) {
+	var fieldSchedules, released []models.FieldSchedule
+	err := s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		fieldSchedules, txErr = s.lockSchedules(ctx, tx, request.FieldScheduleIDs)
+		if txErr != nil {
+			return txErr
+		}
+
+		for _, fieldSchedule := range fieldSchedules {
+			if fieldSchedule.Status != constants.Held {
+				return errFieldSchedule.ErrFieldScheduleNotHeld
+			}
+			txErr = checkHoldReference(fieldSchedule, request.BookingReference)
+			if txErr != nil {
+				return txErr
+			}
 		}
 
+		released, txErr = s.changeStatus(ctx, tx, fieldSchedules, constants.Available, constants.ChangeRelease, nil, nil)
+		return txErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toHoldResponse(fieldSchedules, constants.Available, nil)
+	response.BlockedFieldScheduleIDs = blockedScheduleIDs(released)
+	return response, nil
+}
+
+// checkHoldReference refuses to confirm or release a hold taken under a booking reference unless the same
+// reference is sent, so one order cannot act on another order's hold.
+func checkHoldReference(fieldSchedule models.FieldSchedule, bookingReference *string) error {
+	if fieldSchedule.BookingReference != nil &&
+		(bookingReference == nil || *bookingReference != *fieldSchedule.BookingReference) {
+		return errFieldSchedule.ErrBookingReferenceMismatch
 	}
 	return nil
 }
 
-// Update implements IFieldScheduleRepository.
+// Update implements IFieldScheduleRepository. It moves the schedule to another date and time of the same field
+// and prices it for the new slot. A slot another schedule already takes and a live hold are refused, and so is a
+// booked schedule unless overridden.
 func (s *FieldScheduleService) Update(ctx context.Context, uuid string, request *dto.UpdateFieldScheduleRequest) (*dto.FieldScheduleResponse, error) {
 	fieldSchedule, err := s.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
 	if err != nil {
 		return nil, err
 	}
 
-	scheduleTime, err := s.repository.GetTime().FindByUUID(ctx, request.TimeID)
+	err = venueService.AuthorizeField(ctx, &fieldSchedule.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTime, err := s.findFieldTime(ctx, &fieldSchedule.Field, request.TimeID)
+	if err != nil {
+		return nil, err
+	}
+	if !scheduleTime.IsActive {
+		return nil, errTime.ErrTimeInactive
+	}
+
+	loc := venueService.FieldLocation(&fieldSchedule.Field)
+	dateParsed, err := time.ParseInLocation(time.DateOnly, request.Date, loc)
+	if err != nil {
+		return nil, errFieldSchedule.ErrInvalidDate
+	}
+	if dateParsed.Before(venueService.Today(loc)) {
+		return nil, errFieldSchedule.ErrDateInPast
+	}
+
+	err = s.checkNotClosed(ctx, s.repository.GetTx(), &fieldSchedule.Field, dateParsed)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRules, err := s.repository.GetPricingRule().FindAllByFieldID(ctx, fieldSchedule.FieldID)
 	if err != nil {
 		return nil, err
 	}
+	price := newPriceCalculator(&fieldSchedule.Field, pricingRules).Price(scheduleTime.ID, dateParsed)
 
-	isTimeExist, err := s.repository.GetFieldSchedule().FindByDateAndTimeID(
-		ctx,
-		request.Date,
-		int(scheduleTime.ID),
-		int(fieldSchedule.FieldID),
-	)
+	now := time.Now()
+	var fieldResult *models.FieldSchedule
+	err = s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		locked, txErr := s.lockSchedules(ctx, tx, []string{uuid})
+		if txErr != nil {
+			return txErr
+		}
 
+		txErr = checkOverride(&locked[0], request.Override)
+		if txErr != nil {
+			return txErr
+		}
+		if locked[0].Status == constants.Held && !s.isHoldExpired(locked[0], now) {
+			return errFieldSchedule.ErrFieldScheduleHeld
+		}
+
+		existing, txErr := s.repository.GetFieldSchedule().FindByDateAndTimeID(
+			ctx, tx, request.Date, int(scheduleTime.ID), int(fieldSchedule.FieldID))
+		if txErr != nil {
+			return txErr
+		}
+		if existing != nil && existing.ID != fieldSchedule.ID {
+			return errFieldSchedule.ErrFieldScheduleIsExist
+		}
+
+		fieldResult, txErr = s.repository.GetFieldSchedule().Update(ctx, tx, uuid, &models.FieldSchedule{
+			Date:   dateParsed,
+			TimeID: scheduleTime.ID,
+			Price:  &price,
+		})
+		if txErr != nil {
+			return txErr
+		}
+		return s.recordChange(ctx, tx, constants.AuditUpdate, &locked[0], fieldResult)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if isTimeExist != nil && request.Date != fieldSchedule.Date.Format(time.DateOnly) {
-		checkDate, err := s.repository.GetFieldSchedule().FindByDateAndTimeID(
-			ctx,
-			request.Date,
-			int(scheduleTime.ID),
-			int(fieldSchedule.FieldID),
+	response := scheduleResponse(ctx, *fieldResult)
+	return &response, nil
+}
+
+// UpdatePrice implements IFieldScheduleService.
+func (s *FieldScheduleService) UpdatePrice(
+	ctx context.Context,
+	uuid string,
+	request *dto.UpdateFieldSchedulePriceRequest,
+) (*dto.FieldScheduleResponse, error) {
+	fieldSchedule, err := s.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venueService.AuthorizeField(ctx, &fieldSchedule.Field)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.repository.GetFieldSchedule().UpdatePrice(ctx, uuid, *request.Price)
+	if err != nil {
+		return nil, err
+	}
+
+	after := *fieldSchedule
+	after.Price = request.Price
+	err = s.recordChange(ctx, nil, constants.AuditUpdate, fieldSchedule, &after)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetByUUID(ctx, uuid)
+}
+
+// Delete implements IFieldScheduleRepository. The schedule is locked first so it cannot be booked between the
+// override check and the delete.
+func (s *FieldScheduleService) Delete(
+	ctx context.Context,
+	uuid string,
+	param *dto.DeleteFieldScheduleRequestParam,
+) error {
+	fieldSchedule, err := s.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	err = venueService.AuthorizeField(ctx, &fieldSchedule.Field)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		locked, txErr := s.lockSchedules(ctx, tx, []string{uuid})
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = checkOverride(&locked[0], param.Override)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.repository.GetFieldSchedule().Delete(ctx, tx, uuid)
+		if txErr != nil {
+			return txErr
+		}
+		return s.recordChange(ctx, tx, constants.AuditDelete, &locked[0], nil)
+	})
+}
+
+// Restore implements IFieldScheduleService. A schedule can only come back while no other schedule has taken its
+// field, date and time in the meantime, its time is still active and its date is not closed.
+func (s *FieldScheduleService) Restore(ctx context.Context, uuid string) (*dto.FieldScheduleResponse, error) {
+	err := s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		fieldSchedule, txErr := s.repository.GetFieldSchedule().FindDeletedByUUIDForUpdate(ctx, tx, uuid)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = venueService.AuthorizeField(ctx, &fieldSchedule.Field)
+		if txErr != nil {
+			return txErr
+		}
+
+		if !fieldSchedule.Time.IsActive {
+			return errTime.ErrTimeInactive
+		}
+
+		existing, txErr := s.repository.GetFieldSchedule().FindByDateAndTimeID(
+			ctx, tx, fieldSchedule.Date.Format(time.DateOnly), int(fieldSchedule.TimeID), int(fieldSchedule.FieldID))
+		if txErr != nil {
+			return txErr
+		}
+		if existing != nil {
+			return errFieldSchedule.ErrFieldScheduleIsExist
+		}
+
+		txErr = s.checkNotClosed(ctx, tx, &fieldSchedule.Field, fieldSchedule.Date)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.repository.GetFieldSchedule().Restore(ctx, tx, uuid)
+		if txErr != nil {
+			return txErr
+		}
+
+		restored := *fieldSchedule
+		restored.DeletedAt = nil
+		return s.recordChange(ctx, tx, constants.AuditRestore, fieldSchedule, &restored)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.GetByUUID(ctx, uuid)
+}
+
+// validateRequestParam checks the list filters so a malformed date or unknown status is reported instead of
+// silently matching nothing.
+func validateRequestParam(param *dto.FieldScheduleRequestParam) error {
+	var startDate, endDate time.Time
+	var err error
+	if param.StartDate != nil {
+		startDate, err = time.Parse(time.DateOnly, *param.StartDate)
+		if err != nil {
+			return errFieldSchedule.ErrInvalidDate
+		}
+	}
+	if param.EndDate != nil {
+		endDate, err = time.Parse(time.DateOnly, *param.EndDate)
+		if err != nil {
+			return errFieldSchedule.ErrInvalidDate
+		}
+	}
+	if param.StartDate != nil && param.EndDate != nil && endDate.Before(startDate) {
+		return errFieldSchedule.ErrInvalidDateRange
+	}
+
+	if param.Status != nil && constants.FieldScheduleStatusName(*param.Status).GetStatusInt() == 0 {
+		return errFieldSchedule.ErrInvalidStatus
+	}
+	return nil
+}
+
+// resolveGenerateWindow returns the inclusive date range to generate in the field's timezone. The start
+// defaults to tomorrow and the end is taken from EndDate, then NumberOfDays, then DefaultGenerateScheduleDays.
+func (s *FieldScheduleService) resolveGenerateWindow(
+	request *dto.GenerateFieldScheduleRequest,
+	loc *time.Location,
+) (time.Time, time.Time, error) {
+	today := venueService.Today(loc)
+
+	startDate := today.AddDate(0, 0, 1)
+	if request.StartDate != "" {
+		parsed, err := time.ParseInLocation(time.DateOnly, request.StartDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, errFieldSchedule.ErrInvalidDate
+		}
+		startDate = parsed
+	}
+
+	if startDate.Before(today) {
+		return time.Time{}, time.Time{}, errFieldSchedule.ErrDateInPast
+	}
+
+	var endDate time.Time
+	switch {
+	case request.EndDate != "":
+		parsed, err := time.ParseInLocation(time.DateOnly, request.EndDate, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, errFieldSchedule.ErrInvalidDate
+		}
+		endDate = parsed
+	case request.NumberOfDays > 0:
+		endDate = startDate.AddDate(0, 0, request.NumberOfDays-1)
+	default:
+		endDate = startDate.AddDate(0, 0, constants.DefaultGenerateScheduleDays-1)
+	}
+
+	if endDate.Before(startDate) || endDate.After(startDate.AddDate(0, 0, constants.MaxGenerateScheduleDays-1)) {
+		return time.Time{}, time.Time{}, errFieldSchedule.ErrInvalidDateRange
+	}
+	return startDate, endDate, nil
+}
+
+// resolveWeekdayTimes maps each weekday to the time slots to generate. Without weekdays every day gets
+// every active slot; a weekday without time IDs gets every active slot as well. A field with operating hours
+// uses its own derived slots, any other field the global time presets.
+func (s *FieldScheduleService) resolveWeekdayTimes(
+	ctx context.Context,
+	field *models.Field,
+	weekdays []dto.WeekdayScheduleRequest,
+) (map[time.Weekday][]models.Time, error) {
+	var allTimes []models.Time
+	findAllTimes := func() ([]models.Time, error) {
+		if allTimes != nil {
+			return allTimes, nil
+		}
+		var (
+			times []models.Time
+			err   error
 		)
+		if field.SlotDurationMinute != nil {
+			times, err = s.repository.GetTime().FindAllActiveByFieldID(ctx, field.ID)
+		} else {
+			times, err = s.repository.GetTime().FindAllActive(ctx)
+		}
 		if err != nil {
 			return nil, err
 		}
-		if checkDate != nil {
-			return nil, errFieldSchedule.ErrFieldScheduleIsExist
+		allTimes = times
+		return allTimes, nil
+	}
+
+	timesByWeekday := make(map[time.Weekday][]models.Time, 7)
+	if len(weekdays) == 0 {
+		times, err := findAllTimes()
+		if err != nil {
+			return nil, err
 		}
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			timesByWeekday[day] = times
+		}
+		return timesByWeekday, nil
+	}
+
+	timeByUUID := make(map[string]models.Time)
+	for _, item := range weekdays {
+		weekday, ok := constants.GetWeekday(item.Weekday)
+		if !ok {
+			return nil, errFieldSchedule.ErrInvalidWeekday
+		}
+		if _, ok = timesByWeekday[weekday]; ok {
+			return nil, errFieldSchedule.ErrDuplicateWeekday
+		}
+
+		if len(item.TimeIDs) == 0 {
+			times, err := findAllTimes()
+			if err != nil {
+				return nil, err
+			}
+			timesByWeekday[weekday] = times
+			continue
+		}
+
+		times := make([]models.Time, 0, len(item.TimeIDs))
+		for _, timeID := range item.TimeIDs {
+			scheduleTime, ok := timeByUUID[timeID]
+			if !ok {
+				result, err := s.findFieldTime(ctx, field, timeID)
+				if err != nil {
+					return nil, err
+				}
+				scheduleTime = *result
+				timeByUUID[timeID] = scheduleTime
+			}
+			if !scheduleTime.IsActive {
+				continue
+			}
+			times = append(times, scheduleTime)
+		}
+		timesByWeekday[weekday] = times
+	}
+	return timesByWeekday, nil
+}
+
+// findFieldTime loads a time slot that the field may use: a global preset or one derived for the field itself.
+func (s *FieldScheduleService) findFieldTime(ctx context.Context, field *models.Field, timeID string) (*models.Time, error) {
+	scheduleTime, err := s.repository.GetTime().FindByUUID(ctx, timeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduleTime.FieldID != nil && *scheduleTime.FieldID != field.ID {
+		return nil, errTime.ErrTimeNotFound
+	}
+	return scheduleTime, nil
+}
+
+// createSchedules prices and inserts the slots, leaving any that already exist untouched.
+func (s *FieldScheduleService) createSchedules(
+	ctx context.Context,
+	field *models.Field,
+	fieldSchedules []models.FieldSchedule,
+) (*dto.GenerateFieldScheduleResponse, error) {
+	pricingRules, err := s.repository.GetPricingRule().FindAllByFieldID(ctx, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	closedDates, err := s.closedDates(ctx, field, fieldSchedules)
+	if err != nil {
+		return nil, err
+	}
+
+	calculator := newPriceCalculator(field, pricingRules)
+	openSchedules := make([]models.FieldSchedule, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		if closedDates[fieldSchedule.Date.Format(time.DateOnly)] {
+			continue
+		}
+		price := calculator.Price(fieldSchedule.TimeID, fieldSchedule.Date)
+		fieldSchedule.Price = &price
+		openSchedules = append(openSchedules, fieldSchedule)
 	}
 
-	dateParsed, _ := time.Parse(time.DateOnly, request.Date)
-	fieldResult, err := s.repository.GetFieldSchedule().Update(ctx, uuid, &models.FieldSchedule{
-		Date:   dateParsed,
-		TimeID: scheduleTime.ID,
+	var created []models.FieldSchedule
+	err = s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		created, txErr = s.repository.GetFieldSchedule().Create(ctx, tx, openSchedules)
+		if txErr != nil {
+			return txErr
+		}
+		return s.recordCreated(ctx, tx, created)
 	})
 	if err != nil {
 		return nil, err
 	}
-	response := dto.FieldScheduleResponse{
-		UUID:         fieldResult.UUID,
-		FieldName:    fieldResult.Field.Name,
-		Date:         fieldResult.Date.Format(time.DateOnly),
-		PricePerHour: fieldResult.Field.PricePerHour,
-		Status:       fieldSchedule.Status.GetStatusString(),
-		Time:         fmt.Sprintf("%s - %s", scheduleTime.StartTime, scheduleTime.EndTime),
-		CreatedAt:    fieldResult.CreatedAt,
-		UpdatedAt:    fieldResult.UpdatedAt,
+
+	return &dto.GenerateFieldScheduleResponse{
+		Created: int64(len(created)),
+		Skipped: int64(len(fieldSchedules) - len(created)),
+	}, nil
+}
+
+// closedDates returns the dates covered by a closure of the field, of its venue or of every field.
+func (s *FieldScheduleService) closedDates(
+	ctx context.Context,
+	field *models.Field,
+	fieldSchedules []models.FieldSchedule,
+) (map[string]bool, error) {
+	closedDates := make(map[string]bool)
+	if len(fieldSchedules) == 0 {
+		return closedDates, nil
 	}
-	return &response, nil
 
+	startDate, endDate := fieldSchedules[0].Date, fieldSchedules[0].Date
+	for _, fieldSchedule := range fieldSchedules {
+		if fieldSchedule.Date.Before(startDate) {
+			startDate = fieldSchedule.Date
+		}
+		if fieldSchedule.Date.After(endDate) {
+			endDate = fieldSchedule.Date
+		}
+	}
+
+	closures, err := s.repository.GetClosure().FindOverlapping(
+		ctx, s.repository.GetTx(), &field.ID, field.VenueID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, closure := range closures {
+		for date := closure.StartDate; !date.After(closure.EndDate); date = date.AddDate(0, 0, 1) {
+			closedDates[date.Format(time.DateOnly)] = true
+		}
+	}
+	return closedDates, nil
 }
 
-// Delete implements IFieldScheduleRepository.
-func (s *FieldScheduleService) Delete(ctx context.Context, uuid string) error {
-	_, err := s.repository.GetFieldSchedule().FindByUUID(ctx, uuid)
+// checkNotClosed refuses a date covered by a closure of the field, of its venue or of every field.
+func (s *FieldScheduleService) checkNotClosed(
+	ctx context.Context,
+	tx *gorm.DB,
+	field *models.Field,
+	date time.Time,
+) error {
+	closures, err := s.repository.GetClosure().FindOverlapping(ctx, tx, &field.ID, field.VenueID, date, date)
 	if err != nil {
 		return err
 	}
-	err = s.repository.GetFieldSchedule().Delete(ctx, uuid)
+	if len(closures) > 0 {
+		return errFieldSchedule.ErrFieldScheduleDateClosed
+	}
+	return nil
+}
+
+// lockSchedules loads the requested schedules with a row lock and fails when any of them is missing.
+func (s *FieldScheduleService) lockSchedules(
+	ctx context.Context,
+	tx *gorm.DB,
+	uuids []string,
+) ([]models.FieldSchedule, error) {
+	uniqueUUIDs := make([]string, 0, len(uuids))
+	seen := make(map[string]bool, len(uuids))
+	for _, item := range uuids {
+		if !seen[item] {
+			seen[item] = true
+			uniqueUUIDs = append(uniqueUUIDs, item)
+		}
+	}
+
+	fieldSchedules, err := s.repository.GetFieldSchedule().FindAllByUUIDsForUpdate(ctx, tx, uniqueUUIDs)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
+	if len(fieldSchedules) != len(uniqueUUIDs) {
+		return nil, errFieldSchedule.ErrFieldScheduleNotFound
+	}
+	return fieldSchedules, nil
+}
+
+// isAvailable treats a hold that has already expired as available, so abandoned checkouts
+// do not lock a slot until the expired holds are swept.
+func (s *FieldScheduleService) isAvailable(fieldSchedule models.FieldSchedule, now time.Time) bool {
+	if fieldSchedule.Status == constants.Available {
+		return true
+	}
+	return fieldSchedule.Status == constants.Held && s.isHoldExpired(fieldSchedule, now)
+}
+
+// checkAvailability returns a conflict error naming every schedule that is already taken.
+func (s *FieldScheduleService) checkAvailability(fieldSchedules []models.FieldSchedule, now time.Time) error {
+	conflicts := make([]string, 0)
+	for _, fieldSchedule := range fieldSchedules {
+		if !s.isAvailable(fieldSchedule, now) {
+			conflicts = append(conflicts, fieldSchedule.UUID.String())
+		}
+	}
+
+	if len(conflicts) > 0 {
+		return errFieldSchedule.NewConflictError(conflicts)
+	}
 	return nil
 }
 
+func (s *FieldScheduleService) isHoldExpired(fieldSchedule models.FieldSchedule, now time.Time) bool {
+	return fieldSchedule.HoldExpiresAt != nil && !fieldSchedule.HoldExpiresAt.After(now)
+}
+
+func (s *FieldScheduleService) scheduleIDs(fieldSchedules []models.FieldSchedule) []uint {
+	ids := make([]uint, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		ids = append(ids, fieldSchedule.ID)
+	}
+	return ids
+}
+
+// blockedScheduleIDs lists the schedules that were blocked by a closure instead of becoming available.
+func blockedScheduleIDs(fieldSchedules []models.FieldSchedule) []uuid.UUID {
+	var uuids []uuid.UUID
+	for _, fieldSchedule := range fieldSchedules {
+		if fieldSchedule.Status == constants.Blocked {
+			uuids = append(uuids, fieldSchedule.UUID)
+		}
+	}
+	return uuids
+}
+
+func (s *FieldScheduleService) toHoldResponse(
+	fieldSchedules []models.FieldSchedule,
+	status constants.FieldScheduleStatus,
+	holdExpiresAt *time.Time,
+) *dto.FieldScheduleHoldResponse {
+	uuids := make([]uuid.UUID, 0, len(fieldSchedules))
+	for _, fieldSchedule := range fieldSchedules {
+		uuids = append(uuids, fieldSchedule.UUID)
+	}
+
+	return &dto.FieldScheduleHoldResponse{
+		FieldScheduleIDs: uuids,
+		Status:           status.GetStatusString(),
+		HoldExpiresAt:    holdExpiresAt,
+	}
+}
+
 func (s *FieldScheduleService) convertMonthName(inputDate string) string {
 	date, err := time.Parse(time.DateOnly, inputDate)
 	if err != nil {
@@ -0,0 +1,328 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"testing"
+	"time"
+)
+
+func TestIsAvailable(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 12, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) *time.Time {
+		value := now.Add(offset)
+		return &value
+	}
+
+	tests := []struct {
+		name          string
+		status        constants.FieldScheduleStatus
+		holdExpiresAt *time.Time
+		want          bool
+	}{
+		{name: "available", status: constants.Available, want: true},
+		{name: "hold still running", status: constants.Held, holdExpiresAt: at(time.Second)},
+		{name: "hold expiring right now", status: constants.Held, holdExpiresAt: at(0), want: true},
+		{name: "hold expired", status: constants.Held, holdExpiresAt: at(-time.Second), want: true},
+		{name: "hold without expiry", status: constants.Held},
+		{name: "booked", status: constants.Booked},
+		{name: "booked with a stale hold expiry", status: constants.Booked, holdExpiresAt: at(-time.Hour)},
+		{name: "blocked", status: constants.Blocked},
+	}
+
+	s := &FieldScheduleService{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fieldSchedule := models.FieldSchedule{Status: tt.status, HoldExpiresAt: tt.holdExpiresAt}
+			if got := s.isAvailable(fieldSchedule, now); got != tt.want {
+				t.Errorf("isAvailable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHold(t *testing.T) {
+	t.Run("locks and holds the slots with the history and audit", func(t *testing.T) {
+		first := newTestSchedule(1, constants.Available, 7, 100000)
+		second := newTestSchedule(2, constants.Available, 7, 100000)
+		second.Time = models.Time{StartTime: "11:00:00", EndTime: "12:00:00"}
+		registry := newFakeRegistry(t, first, second)
+		s := &FieldScheduleService{repository: registry}
+
+		response, err := s.Hold(context.Background(), &dto.HoldFieldScheduleRequest{
+			FieldScheduleIDs:   []string{first.UUID.String(), second.UUID.String(), first.UUID.String()},
+			HoldMinutes:        10,
+			BookingReference:   ptr("ORDER-1"),
+			RequireConsecutive: true,
+		})
+		if err != nil {
+			t.Fatalf("Hold() error = %v", err)
+		}
+
+		registry.assertTx(t, 1, 0)
+		if locked := registry.fieldSchedule.locked; len(locked) == 0 || len(locked[0]) != 2 {
+			t.Errorf("locked %v, want both slots locked once up front", locked)
+		}
+		for _, fieldSchedule := range registry.fieldSchedule.schedules {
+			if fieldSchedule.Status != constants.Held || fieldSchedule.HoldExpiresAt == nil {
+				t.Errorf("slot %d status = %d, hold expires at %v, want a running hold",
+					fieldSchedule.ID, fieldSchedule.Status, fieldSchedule.HoldExpiresAt)
+			}
+			if fieldSchedule.BookingReference == nil || *fieldSchedule.BookingReference != "ORDER-1" {
+				t.Errorf("slot %d booking reference = %v, want ORDER-1", fieldSchedule.ID, fieldSchedule.BookingReference)
+			}
+		}
+		for _, history := range registry.fieldSchedule.histories {
+			if history.FromStatus != constants.Available || history.ToStatus != constants.Held ||
+				history.Reason != constants.ChangeHold {
+				t.Errorf("history = %d -> %d (%s), want Available -> Held (hold)",
+					history.FromStatus, history.ToStatus, history.Reason)
+			}
+		}
+		if len(registry.fieldSchedule.histories) != 2 || len(registry.auditLog.auditLogs) != 2 {
+			t.Errorf("wrote %d histories and %d audit logs, want 2 of each",
+				len(registry.fieldSchedule.histories), len(registry.auditLog.auditLogs))
+		}
+		if response.TotalDurationMinute != 120 || response.TotalPrice != 200000 {
+			t.Errorf("response totals = %d minutes, %d, want 120 minutes, 200000",
+				response.TotalDurationMinute, response.TotalPrice)
+		}
+	})
+
+	t.Run("takes over an expired hold", func(t *testing.T) {
+		fieldSchedule := newTestSchedule(1, constants.Held, 7, 100000)
+		fieldSchedule.HoldExpiresAt = ptr(time.Now().Add(-time.Minute))
+		registry := newFakeRegistry(t, fieldSchedule)
+		s := &FieldScheduleService{repository: registry}
+
+		_, err := s.Hold(context.Background(), &dto.HoldFieldScheduleRequest{
+			FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+		})
+		if err != nil {
+			t.Fatalf("Hold() error = %v", err)
+		}
+		registry.assertTx(t, 1, 0)
+		if got := registry.fieldSchedule.schedules[1].HoldExpiresAt; got == nil || !got.After(time.Now()) {
+			t.Errorf("hold expires at %v, want a new hold", got)
+		}
+	})
+
+	tests := []struct {
+		name      string
+		status    constants.FieldScheduleStatus
+		uuid      string
+		auditErr  error
+		wantErr   error
+		wantState constants.FieldScheduleStatus
+	}{
+		{name: "booked slot", status: constants.Booked, wantErr: errFieldSchedule.ErrFieldScheduleNotAvailable},
+		{name: "blocked slot", status: constants.Blocked, wantErr: errFieldSchedule.ErrFieldScheduleNotAvailable},
+		{name: "missing slot", status: constants.Available, uuid: "missing",
+			wantErr: errFieldSchedule.ErrFieldScheduleNotFound},
+		{name: "failed audit", status: constants.Available, auditErr: errors.New("audit down")},
+	}
+	for _, tt := range tests {
+		t.Run("rolls back on "+tt.name, func(t *testing.T) {
+			fieldSchedule := newTestSchedule(1, tt.status, 7, 100000)
+			registry := newFakeRegistry(t, fieldSchedule)
+			registry.auditLog.err = tt.auditErr
+			s := &FieldScheduleService{repository: registry}
+
+			ids := []string{fieldSchedule.UUID.String()}
+			if tt.uuid != "" {
+				ids = append(ids, tt.uuid)
+			}
+			_, err := s.Hold(context.Background(), &dto.HoldFieldScheduleRequest{FieldScheduleIDs: ids})
+			if err == nil {
+				t.Fatal("Hold() error = nil, want an error")
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("Hold() error = %v, want %v", err, tt.wantErr)
+			}
+			registry.assertTx(t, 0, 1)
+			if tt.auditErr == nil && len(registry.fieldSchedule.histories) != 0 {
+				t.Errorf("wrote %d histories, want none", len(registry.fieldSchedule.histories))
+			}
+		})
+	}
+}
+
+func TestConfirm(t *testing.T) {
+	customerUUID := "5f0c3c1e-8d43-4f3b-9a35-1c2d3e4f5a6b"
+
+	t.Run("books the hold and keeps its reference", func(t *testing.T) {
+		fieldSchedule := newTestSchedule(1, constants.Held, 7, 100000)
+		fieldSchedule.HoldExpiresAt = ptr(time.Now().Add(time.Minute))
+		registry := newFakeRegistry(t, fieldSchedule)
+		s := &FieldScheduleService{repository: registry}
+
+		_, err := s.Confirm(context.Background(), &dto.UpdateStatusScheduleRquest{
+			FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+			CustomerUUID:     &customerUUID,
+		})
+		if err != nil {
+			t.Fatalf("Confirm() error = %v", err)
+		}
+
+		registry.assertTx(t, 1, 0)
+		confirmed := registry.fieldSchedule.schedules[1]
+		if confirmed.Status != constants.Booked || confirmed.HoldExpiresAt != nil || confirmed.BookedAt == nil {
+			t.Errorf("slot = status %d, hold expires at %v, booked at %v, want booked",
+				confirmed.Status, confirmed.HoldExpiresAt, confirmed.BookedAt)
+		}
+		if confirmed.CustomerUUID == nil || confirmed.CustomerUUID.String() != customerUUID {
+			t.Errorf("customer = %v, want %s", confirmed.CustomerUUID, customerUUID)
+		}
+		histories := registry.fieldSchedule.histories
+		if len(histories) != 1 || histories[0].ToStatus != constants.Booked ||
+			histories[0].Reason != constants.ChangeConfirm {
+			t.Errorf("histories = %+v, want one confirmation", histories)
+		}
+	})
+
+	tests := []struct {
+		name          string
+		status        constants.FieldScheduleStatus
+		holdExpiresAt *time.Time
+		wantErr       error
+	}{
+		{name: "available slot", status: constants.Available, wantErr: errFieldSchedule.ErrFieldScheduleNotHeld},
+		{name: "booked slot", status: constants.Booked, wantErr: errFieldSchedule.ErrFieldScheduleNotHeld},
+		{name: "expired hold", status: constants.Held, holdExpiresAt: ptr(time.Now().Add(-time.Second)),
+			wantErr: errFieldSchedule.ErrFieldScheduleHoldExpired},
+	}
+	for _, tt := range tests {
+		t.Run("refuses "+tt.name, func(t *testing.T) {
+			fieldSchedule := newTestSchedule(1, tt.status, 7, 100000)
+			fieldSchedule.HoldExpiresAt = tt.holdExpiresAt
+			registry := newFakeRegistry(t, fieldSchedule)
+			s := &FieldScheduleService{repository: registry}
+
+			_, err := s.Confirm(context.Background(), &dto.UpdateStatusScheduleRquest{
+				FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Confirm() error = %v, want %v", err, tt.wantErr)
+			}
+			registry.assertTx(t, 0, 1)
+			if got := registry.fieldSchedule.schedules[1].Status; got != tt.status {
+				t.Errorf("status = %d, want it unchanged at %d", got, tt.status)
+			}
+		})
+	}
+}
+
+func TestRelease(t *testing.T) {
+	t.Run("makes the hold available and keeps the reference in the history", func(t *testing.T) {
+		fieldSchedule := newTestSchedule(1, constants.Held, 7, 100000)
+		fieldSchedule.HoldExpiresAt = ptr(time.Now().Add(time.Minute))
+		fieldSchedule.BookingReference = ptr("ORDER-1")
+		registry := newFakeRegistry(t, fieldSchedule)
+		s := &FieldScheduleService{repository: registry}
+
+		response, err := s.Release(context.Background(), &dto.UpdateStatusScheduleRquest{
+			FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+			BookingReference: ptr("ORDER-1"),
+		})
+		if err != nil {
+			t.Fatalf("Release() error = %v", err)
+		}
+
+		registry.assertTx(t, 1, 0)
+		released := registry.fieldSchedule.schedules[1]
+		if released.Status != constants.Available || released.HoldExpiresAt != nil || released.BookingReference != nil {
+			t.Errorf("slot = status %d, hold expires at %v, reference %v, want available and cleared",
+				released.Status, released.HoldExpiresAt, released.BookingReference)
+		}
+		histories := registry.fieldSchedule.histories
+		if len(histories) != 1 || histories[0].Reason != constants.ChangeRelease ||
+			histories[0].BookingReference == nil || *histories[0].BookingReference != "ORDER-1" {
+			t.Errorf("histories = %+v, want one release under ORDER-1", histories)
+		}
+		if response.Status != constants.AvailableString {
+			t.Errorf("response status = %s, want %s", response.Status, constants.AvailableString)
+		}
+	})
+
+	t.Run("refuses a slot that is not held", func(t *testing.T) {
+		fieldSchedule := newTestSchedule(1, constants.Booked, 7, 100000)
+		registry := newFakeRegistry(t, fieldSchedule)
+		s := &FieldScheduleService{repository: registry}
+
+		_, err := s.Release(context.Background(), &dto.UpdateStatusScheduleRquest{
+			FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+		})
+		if !errors.Is(err, errFieldSchedule.ErrFieldScheduleNotHeld) {
+			t.Fatalf("Release() error = %v, want %v", err, errFieldSchedule.ErrFieldScheduleNotHeld)
+		}
+		registry.assertTx(t, 0, 1)
+	})
+}
+
+func TestCheckHoldReference(t *testing.T) {
+	tests := []struct {
+		name             string
+		holdReference    *string
+		requestReference *string
+		wantErr          error
+	}{
+		{name: "same reference", holdReference: ptr("ORDER-1"), requestReference: ptr("ORDER-1")},
+		{name: "hold without reference", requestReference: ptr("ORDER-1")},
+		{name: "neither has a reference"},
+		{name: "another reference", holdReference: ptr("ORDER-1"), requestReference: ptr("ORDER-2"),
+			wantErr: errFieldSchedule.ErrBookingReferenceMismatch},
+		{name: "reference left out", holdReference: ptr("ORDER-1"),
+			wantErr: errFieldSchedule.ErrBookingReferenceMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fieldSchedule := models.FieldSchedule{Booking: models.Booking{BookingReference: tt.holdReference}}
+			if err := checkHoldReference(fieldSchedule, tt.requestReference); !errors.Is(err, tt.wantErr) {
+				t.Errorf("checkHoldReference() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestHoldReferenceMismatch(t *testing.T) {
+	transitions := map[string]func(*FieldScheduleService, *dto.UpdateStatusScheduleRquest) error{
+		"confirm": func(s *FieldScheduleService, request *dto.UpdateStatusScheduleRquest) error {
+			_, err := s.Confirm(context.Background(), request)
+			return err
+		},
+		"release": func(s *FieldScheduleService, request *dto.UpdateStatusScheduleRquest) error {
+			_, err := s.Release(context.Background(), request)
+			return err
+		},
+	}
+
+	for name, transition := range transitions {
+		for _, reference := range []*string{nil, ptr("ORDER-2")} {
+			t.Run(name, func(t *testing.T) {
+				fieldSchedule := newTestSchedule(1, constants.Held, 7, 100000)
+				fieldSchedule.HoldExpiresAt = ptr(time.Now().Add(time.Minute))
+				fieldSchedule.BookingReference = ptr("ORDER-1")
+				registry := newFakeRegistry(t, fieldSchedule)
+				s := &FieldScheduleService{repository: registry}
+
+				err := transition(s, &dto.UpdateStatusScheduleRquest{
+					FieldScheduleIDs: []string{fieldSchedule.UUID.String()},
+					BookingReference: reference,
+				})
+				if !errors.Is(err, errFieldSchedule.ErrBookingReferenceMismatch) {
+					t.Fatalf("%s error = %v, want %v", name, err, errFieldSchedule.ErrBookingReferenceMismatch)
+				}
+				registry.assertTx(t, 0, 1)
+				held := registry.fieldSchedule.schedules[1]
+				if held.Status != constants.Held || *held.BookingReference != "ORDER-1" {
+					t.Errorf("slot = status %d under %v, want the hold untouched", held.Status, *held.BookingReference)
+				}
+			})
+		}
+	}
+}
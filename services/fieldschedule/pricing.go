@@ -0,0 +1,93 @@
+package services
+
+import (
+	"field-service/constants"
+	"field-service/domain/models"
+	"fmt"
+	"time"
+)
+
+// priceCalculator resolves the effective price of a slot from the field's base price and its pricing rules.
+// A time slot rule replaces the base price, weekend and holiday surcharges are added on top, and a date
+// override wins over everything else.
+type priceCalculator struct {
+	basePrice         int
+	timeSlotPrices    map[uint]int
+	weekendSurcharge  int
+	holidaySurcharges map[string]int
+	dateOverrides     map[string]int
+}
+
+func newPriceCalculator(field *models.Field, pricingRules []models.PricingRule) *priceCalculator {
+	calculator := &priceCalculator{
+		basePrice:         field.PricePerHour,
+		timeSlotPrices:    make(map[uint]int),
+		holidaySurcharges: make(map[string]int),
+		dateOverrides:     make(map[string]int),
+	}
+
+	for _, rule := range pricingRules {
+		switch rule.Type {
+		case constants.TimeSlotPricing:
+			if rule.TimeID != nil {
+				calculator.timeSlotPrices[*rule.TimeID] = rule.Price
+			}
+		case constants.WeekendPricing:
+			calculator.weekendSurcharge = max(calculator.weekendSurcharge, rule.SurchargePercent)
+		case constants.HolidayPricing:
+			if rule.Date != nil {
+				date := rule.Date.Format(time.DateOnly)
+				calculator.holidaySurcharges[date] = max(calculator.holidaySurcharges[date], rule.SurchargePercent)
+			}
+		case constants.DatePricing:
+			if rule.Date != nil {
+				calculator.dateOverrides[dateOverrideKey(*rule.Date, rule.TimeID)] = rule.Price
+			}
+		}
+	}
+	return calculator
+}
+
+func (p *priceCalculator) Price(timeID uint, date time.Time) int {
+	if price, ok := p.dateOverrides[dateOverrideKey(date, &timeID)]; ok {
+		return price
+	}
+	if price, ok := p.dateOverrides[dateOverrideKey(date, nil)]; ok {
+		return price
+	}
+
+	price := p.basePrice
+	if slotPrice, ok := p.timeSlotPrices[timeID]; ok {
+		price = slotPrice
+	}
+
+	surcharge := p.holidaySurcharges[date.Format(time.DateOnly)]
+	if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+		surcharge = max(surcharge, p.weekendSurcharge)
+	}
+	return price + price*surcharge/100
+}
+
+func dateOverrideKey(date time.Time, timeID *uint) string {
+	if timeID == nil {
+		return date.Format(time.DateOnly)
+	}
+	return fmt.Sprintf("%s:%d", date.Format(time.DateOnly), *timeID)
+}
+
+// effectivePrice falls back to the field's flat rate for slots generated before pricing existed.
+func effectivePrice(fieldSchedule models.FieldSchedule) int {
+	if fieldSchedule.Price != nil {
+		return *fieldSchedule.Price
+	}
+	return fieldSchedule.Field.PricePerHour
+}
+
+// slotPrice is what the slot costs as a whole: its hourly price over the slot's duration.
+func slotPrice(fieldSchedule models.FieldSchedule) (int, error) {
+	duration, err := slotDurationMinute(fieldSchedule.Time)
+	if err != nil {
+		return 0, err
+	}
+	return effectivePrice(fieldSchedule) * duration / 60, nil
+}
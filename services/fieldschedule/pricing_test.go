@@ -0,0 +1,135 @@
+package services
+
+import (
+	"field-service/constants"
+	"field-service/domain/models"
+	"testing"
+	"time"
+)
+
+func TestPriceCalculator(t *testing.T) {
+	saturday := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, time.June, 3, 0, 0, 0, 0, time.UTC)
+	holiday := time.Date(2024, time.June, 4, 0, 0, 0, 0, time.UTC)
+	peakTimeID, offPeakTimeID := uint(1), uint(2)
+
+	field := &models.Field{PricePerHour: 100000}
+	pricingRules := []models.PricingRule{
+		{Type: constants.TimeSlotPricing, TimeID: &peakTimeID, Price: 150000},
+		{Type: constants.WeekendPricing, SurchargePercent: 10},
+		{Type: constants.WeekendPricing, SurchargePercent: 20},
+		{Type: constants.HolidayPricing, Date: &holiday, SurchargePercent: 50},
+		{Type: constants.HolidayPricing, Date: &saturday, SurchargePercent: 5},
+		{Type: constants.DatePricing, Date: &monday, TimeID: &peakTimeID, Price: 90000},
+		{Type: constants.TimeSlotPricing, Price: 1},
+	}
+	calculator := newPriceCalculator(field, pricingRules)
+
+	wednesday := holiday.AddDate(0, 0, 1)
+
+	tests := []struct {
+		name   string
+		timeID uint
+		date   time.Time
+		want   int
+	}{
+		{name: "base price on a weekday", timeID: offPeakTimeID, date: wednesday, want: 100000},
+		{name: "time slot price replaces the base price", timeID: peakTimeID, date: wednesday, want: 150000},
+		{name: "highest weekend surcharge beats a lower holiday", timeID: offPeakTimeID, date: saturday, want: 120000},
+		{name: "surcharge applies on top of the time slot price", timeID: peakTimeID, date: saturday, want: 180000},
+		{name: "holiday surcharge", timeID: offPeakTimeID, date: holiday, want: 150000},
+		{name: "date override for the slot wins over everything", timeID: peakTimeID, date: monday, want: 90000},
+		{name: "date override for another slot does not apply", timeID: offPeakTimeID, date: monday, want: 100000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := calculator.Price(tt.timeID, tt.date); got != tt.want {
+				t.Errorf("Price() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEffectivePrice(t *testing.T) {
+	price := 75000
+	tests := []struct {
+		name          string
+		fieldSchedule models.FieldSchedule
+		want          int
+	}{
+		{
+			name:          "priced schedule",
+			fieldSchedule: models.FieldSchedule{Price: &price, Field: models.Field{PricePerHour: 100000}},
+			want:          75000,
+		},
+		{
+			name:          "schedule from before pricing falls back to the field rate",
+			fieldSchedule: models.FieldSchedule{Field: models.Field{PricePerHour: 100000}},
+			want:          100000,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := effectivePrice(tt.fieldSchedule); got != tt.want {
+				t.Errorf("effectivePrice() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSlotPrice(t *testing.T) {
+	price := 90000
+	tests := []struct {
+		name          string
+		fieldSchedule models.FieldSchedule
+		want          int
+		wantErr       bool
+	}{
+		{
+			name: "one hour slot costs the hourly price",
+			fieldSchedule: models.FieldSchedule{
+				Price: &price,
+				Time:  models.Time{StartTime: "08:00:00", EndTime: "09:00:00"},
+			},
+			want: 90000,
+		},
+		{
+			name: "two hour slot costs twice as much",
+			fieldSchedule: models.FieldSchedule{
+				Price: &price,
+				Time:  models.Time{StartTime: "08:00:00", EndTime: "10:00:00"},
+			},
+			want: 180000,
+		},
+		{
+			name: "half hour slot at the field rate",
+			fieldSchedule: models.FieldSchedule{
+				Field: models.Field{PricePerHour: 100000},
+				Time:  models.Time{StartTime: "23:30:00", EndTime: "00:00:00"},
+			},
+			want: 50000,
+		},
+		{
+			name: "malformed time",
+			fieldSchedule: models.FieldSchedule{
+				Price: &price,
+				Time:  models.Time{StartTime: "8am", EndTime: "09:00:00"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := slotPrice(tt.fieldSchedule)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("slotPrice() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("slotPrice() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
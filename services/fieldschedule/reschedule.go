@@ -0,0 +1,129 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	venueService "field-service/services/venue"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Reschedule implements IFieldScheduleService. In one transaction the booked schedule becomes available and the
+// target is booked under the same booking reference and customer. The booked schedule must still be outside
+// the cancellation cutoff and the target must not have started yet.
+func (s *FieldScheduleService) Reschedule(
+	ctx context.Context,
+	request *dto.RescheduleFieldScheduleRequest,
+) (*dto.FieldScheduleRescheduleResponse, error) {
+	if request.FieldScheduleID == request.TargetFieldScheduleID {
+		return nil, errFieldSchedule.ErrSameFieldSchedule
+	}
+
+	now := time.Now()
+	deadline := now.Add(time.Duration(cancellationCutoffHour()) * time.Hour)
+	var result *dto.FieldScheduleRescheduleResponse
+	err := s.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		fieldSchedules, txErr := s.lockSchedules(
+			ctx, tx, []string{request.FieldScheduleID, request.TargetFieldScheduleID})
+		if txErr != nil {
+			return txErr
+		}
+
+		var source, target models.FieldSchedule
+		for _, fieldSchedule := range fieldSchedules {
+			if fieldSchedule.UUID.String() == request.FieldScheduleID {
+				source = fieldSchedule
+			} else {
+				target = fieldSchedule
+			}
+		}
+
+		txErr = s.checkCancellable(ctx, tx, []models.FieldSchedule{source}, request.BookingReference, deadline)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = s.checkAvailability([]models.FieldSchedule{target}, now)
+		if txErr != nil {
+			return txErr
+		}
+
+		priceDifference, txErr := s.checkRescheduleTarget(ctx, tx, source, target, now, request.AcceptPriceDifference)
+		if txErr != nil {
+			return txErr
+		}
+
+		booking := source.Booking
+		_, txErr = s.changeStatus(
+			ctx, tx, []models.FieldSchedule{source}, constants.Available, constants.ChangeReschedule, nil, nil)
+		if txErr != nil {
+			return txErr
+		}
+
+		_, txErr = s.changeStatus(
+			ctx, tx, []models.FieldSchedule{target}, constants.Booked, constants.ChangeReschedule, nil, &booking)
+		if txErr != nil {
+			return txErr
+		}
+
+		result = &dto.FieldScheduleRescheduleResponse{
+			FromFieldScheduleID: source.UUID,
+			ToFieldScheduleID:   target.UUID,
+			BookingReference:    booking.BookingReference,
+			CustomerUUID:        booking.CustomerUUID,
+			PriceDifference:     priceDifference,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// checkRescheduleTarget refuses a target that has already started, and one costing a different amount from the
+// booked schedule unless the difference is accepted. Slots are compared on their whole price, so a longer slot at
+// the same hourly rate costs more. It returns the target price minus the booked one.
+func (s *FieldScheduleService) checkRescheduleTarget(
+	ctx context.Context,
+	tx *gorm.DB,
+	source models.FieldSchedule,
+	target models.FieldSchedule,
+	now time.Time,
+	acceptPriceDifference bool,
+) (int, error) {
+	details, err := s.repository.GetFieldSchedule().FindAllByIDs(ctx, tx, []uint{source.ID, target.ID})
+	if err != nil {
+		return 0, err
+	}
+
+	var sourcePrice, targetPrice int
+	for _, detail := range details {
+		price, err := slotPrice(detail)
+		if err != nil {
+			return 0, err
+		}
+		if detail.ID == source.ID {
+			sourcePrice = price
+			continue
+		}
+		targetPrice = price
+		start, err := slotStart(detail, venueService.FieldLocation(&detail.Field))
+		if err != nil {
+			return 0, err
+		}
+		if !start.After(now) {
+			return 0, errFieldSchedule.ErrDateInPast
+		}
+	}
+
+	priceDifference := targetPrice - sourcePrice
+	if priceDifference != 0 && !acceptPriceDifference {
+		return 0, errFieldSchedule.ErrPriceDifference
+	}
+	return priceDifference, nil
+}
@@ -0,0 +1,125 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"testing"
+)
+
+func TestReschedule(t *testing.T) {
+	newRequest := func(source models.FieldSchedule, target models.FieldSchedule) *dto.RescheduleFieldScheduleRequest {
+		return &dto.RescheduleFieldScheduleRequest{
+			FieldScheduleID:       source.UUID.String(),
+			TargetFieldScheduleID: target.UUID.String(),
+			BookingReference:      ptr("ORDER-1"),
+		}
+	}
+
+	t.Run("moves the booking to the target in one transaction", func(t *testing.T) {
+		source := newTestSchedule(1, constants.Booked, 7, 100000)
+		source.BookingReference = ptr("ORDER-1")
+		target := newTestSchedule(2, constants.Available, 8, 100000)
+		registry := newFakeRegistry(t, source, target)
+		s := &FieldScheduleService{repository: registry}
+
+		response, err := s.Reschedule(context.Background(), newRequest(source, target))
+		if err != nil {
+			t.Fatalf("Reschedule() error = %v", err)
+		}
+
+		registry.assertTx(t, 1, 0)
+		if locked := registry.fieldSchedule.locked; len(locked) == 0 || len(locked[0]) != 2 {
+			t.Errorf("locked %v, want both slots locked up front", locked)
+		}
+		moved := registry.fieldSchedule.schedules
+		if moved[1].Status != constants.Available || moved[1].BookingReference != nil {
+			t.Errorf("source = status %d under %v, want available", moved[1].Status, moved[1].BookingReference)
+		}
+		if moved[2].Status != constants.Booked || moved[2].BookingReference == nil ||
+			*moved[2].BookingReference != "ORDER-1" {
+			t.Errorf("target = status %d under %v, want booked under ORDER-1",
+				moved[2].Status, moved[2].BookingReference)
+		}
+		histories := registry.fieldSchedule.histories
+		if len(histories) != 2 {
+			t.Fatalf("wrote %d histories, want 2", len(histories))
+		}
+		for _, history := range histories {
+			if history.Reason != constants.ChangeReschedule {
+				t.Errorf("history reason = %s, want %s", history.Reason, constants.ChangeReschedule)
+			}
+		}
+		if response.PriceDifference != 0 || *response.BookingReference != "ORDER-1" {
+			t.Errorf("response = %+v, want no price difference under ORDER-1", response)
+		}
+	})
+
+	t.Run("charges a longer target slot the difference when accepted", func(t *testing.T) {
+		source := newTestSchedule(1, constants.Booked, 7, 100000)
+		source.BookingReference = ptr("ORDER-1")
+		target := newTestSchedule(2, constants.Available, 8, 100000)
+		target.Time = models.Time{StartTime: "10:00:00", EndTime: "12:00:00"}
+		registry := newFakeRegistry(t, source, target)
+		s := &FieldScheduleService{repository: registry}
+
+		request := newRequest(source, target)
+		request.AcceptPriceDifference = true
+		response, err := s.Reschedule(context.Background(), request)
+		if err != nil {
+			t.Fatalf("Reschedule() error = %v", err)
+		}
+		registry.assertTx(t, 1, 0)
+		if response.PriceDifference != 100000 {
+			t.Errorf("price difference = %d, want 100000", response.PriceDifference)
+		}
+	})
+
+	tests := []struct {
+		name         string
+		sourceStatus constants.FieldScheduleStatus
+		targetStatus constants.FieldScheduleStatus
+		targetPrice  int
+		wantErr      error
+	}{
+		{name: "a target priced differently", sourceStatus: constants.Booked, targetStatus: constants.Available,
+			targetPrice: 120000, wantErr: errFieldSchedule.ErrPriceDifference},
+		{name: "a taken target", sourceStatus: constants.Booked, targetStatus: constants.Booked,
+			targetPrice: 100000, wantErr: errFieldSchedule.ErrFieldScheduleNotAvailable},
+		{name: "a source that is not booked", sourceStatus: constants.Available, targetStatus: constants.Available,
+			targetPrice: 100000, wantErr: errFieldSchedule.ErrFieldScheduleNotBooked},
+	}
+	for _, tt := range tests {
+		t.Run("refuses "+tt.name, func(t *testing.T) {
+			source := newTestSchedule(1, tt.sourceStatus, 7, 100000)
+			source.BookingReference = ptr("ORDER-1")
+			target := newTestSchedule(2, tt.targetStatus, 8, tt.targetPrice)
+			registry := newFakeRegistry(t, source, target)
+			s := &FieldScheduleService{repository: registry}
+
+			_, err := s.Reschedule(context.Background(), newRequest(source, target))
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Reschedule() error = %v, want %v", err, tt.wantErr)
+			}
+			registry.assertTx(t, 0, 1)
+			if len(registry.fieldSchedule.histories) != 0 {
+				t.Errorf("wrote %d histories, want none", len(registry.fieldSchedule.histories))
+			}
+		})
+	}
+
+	t.Run("refuses the same slot as target", func(t *testing.T) {
+		source := newTestSchedule(1, constants.Booked, 7, 100000)
+		registry := newFakeRegistry(t, source)
+		s := &FieldScheduleService{repository: registry}
+
+		_, err := s.Reschedule(context.Background(), newRequest(source, source))
+		if !errors.Is(err, errFieldSchedule.ErrSameFieldSchedule) {
+			t.Fatalf("Reschedule() error = %v, want %v", err, errFieldSchedule.ErrSameFieldSchedule)
+		}
+		registry.assertTx(t, 0, 0)
+	})
+}
@@ -0,0 +1,233 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errPricingRule "field-service/constants/error/pricingrule"
+	errTime "field-service/constants/error/time"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	venueService "field-service/services/venue"
+	"fmt"
+	"time"
+)
+
+type PricingRuleService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IPricingRuleService interface {
+	GetAllByFieldUUID(context.Context, string) ([]dto.PricingRuleResponse, error)
+	GetByUUID(context.Context, string, string) (*dto.PricingRuleResponse, error)
+	Create(context.Context, string, *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Update(context.Context, string, string, *dto.PricingRuleRequest) (*dto.PricingRuleResponse, error)
+	Delete(context.Context, string, string) error
+}
+
+func NewPricingRuleService(repository repositories.IRepositoryRegistry) IPricingRuleService {
+	return &PricingRuleService{repository: repository}
+}
+
+// GetAllByFieldUUID implements IPricingRuleService.
+func (p *PricingRuleService) GetAllByFieldUUID(ctx context.Context, fieldUUID string) ([]dto.PricingRuleResponse, error) {
+	field, err := p.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRules, err := p.repository.GetPricingRule().FindAllByFieldID(ctx, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.PricingRuleResponse, 0, len(pricingRules))
+	for _, pricingRule := range pricingRules {
+		results = append(results, p.toResponse(&pricingRule))
+	}
+	return results, nil
+}
+
+// GetByUUID implements IPricingRuleService.
+func (p *PricingRuleService) GetByUUID(ctx context.Context, fieldUUID string, uuid string) (*dto.PricingRuleResponse, error) {
+	pricingRule, err := p.findByFieldAndUUID(ctx, fieldUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := p.toResponse(pricingRule)
+	return &response, nil
+}
+
+// Create implements IPricingRuleService.
+func (p *PricingRuleService) Create(
+	ctx context.Context,
+	fieldUUID string,
+	request *dto.PricingRuleRequest,
+) (*dto.PricingRuleResponse, error) {
+	field, err := p.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := p.resolveRule(ctx, field.ID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.FieldID = field.ID
+	pricingRule, err := p.repository.GetPricingRule().Create(ctx, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRule.Field = *field
+	pricingRule.Time = rule.Time
+	response := p.toResponse(pricingRule)
+	return &response, nil
+}
+
+// Update implements IPricingRuleService.
+func (p *PricingRuleService) Update(
+	ctx context.Context,
+	fieldUUID string,
+	uuid string,
+	request *dto.PricingRuleRequest,
+) (*dto.PricingRuleResponse, error) {
+	existing, err := p.findByFieldAndUUID(ctx, fieldUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	rule, err := p.resolveRule(ctx, existing.FieldID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRule, err := p.repository.GetPricingRule().Update(ctx, uuid, rule)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRule.Time = rule.Time
+	response := p.toResponse(pricingRule)
+	return &response, nil
+}
+
+// Delete implements IPricingRuleService.
+func (p *PricingRuleService) Delete(ctx context.Context, fieldUUID string, uuid string) error {
+	_, err := p.findByFieldAndUUID(ctx, fieldUUID, uuid)
+	if err != nil {
+		return err
+	}
+
+	return p.repository.GetPricingRule().Delete(ctx, uuid)
+}
+
+// findField loads the field in the path and checks the user may manage it.
+func (p *PricingRuleService) findField(ctx context.Context, fieldUUID string) (*models.Field, error) {
+	field, err := p.repository.GetField().FindByUUID(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venueService.AuthorizeField(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// findByFieldAndUUID makes sure the rule belongs to the field in the path.
+func (p *PricingRuleService) findByFieldAndUUID(
+	ctx context.Context,
+	fieldUUID string,
+	uuid string,
+) (*models.PricingRule, error) {
+	_, err := p.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	pricingRule, err := p.repository.GetPricingRule().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if pricingRule.Field.UUID.String() != fieldUUID {
+		return nil, errPricingRule.ErrPricingRuleNotFound
+	}
+	return pricingRule, nil
+}
+
+// resolveRule checks that the request carries what its rule type needs and loads the referenced time slot, which
+// must be a global preset or one derived for the field itself.
+func (p *PricingRuleService) resolveRule(
+	ctx context.Context,
+	fieldID uint,
+	request *dto.PricingRuleRequest,
+) (*models.PricingRule, error) {
+	if !request.Type.IsValid() {
+		return nil, errPricingRule.ErrInvalidPricingRuleType
+	}
+
+	rule := &models.PricingRule{
+		Name:             request.Name,
+		Type:             request.Type,
+		Price:            request.Price,
+		SurchargePercent: request.SurchargePercent,
+	}
+
+	if request.Type == constants.TimeSlotPricing && request.TimeID == "" {
+		return nil, errPricingRule.ErrPricingRuleTimeID
+	}
+	if request.TimeID != "" && (request.Type == constants.TimeSlotPricing || request.Type == constants.DatePricing) {
+		scheduleTime, err := p.repository.GetTime().FindByUUID(ctx, request.TimeID)
+		if err != nil {
+			return nil, err
+		}
+		if scheduleTime.FieldID != nil && *scheduleTime.FieldID != fieldID {
+			return nil, errTime.ErrTimeNotFound
+		}
+		rule.TimeID = &scheduleTime.ID
+		rule.Time = scheduleTime
+	}
+
+	if request.Type == constants.HolidayPricing || request.Type == constants.DatePricing {
+		if request.Date == "" {
+			return nil, errPricingRule.ErrPricingRuleDate
+		}
+		date, err := time.Parse(time.DateOnly, request.Date)
+		if err != nil {
+			return nil, errFieldSchedule.ErrInvalidDate
+		}
+		rule.Date = &date
+	}
+	return rule, nil
+}
+
+func (p *PricingRuleService) toResponse(pricingRule *models.PricingRule) dto.PricingRuleResponse {
+	var scheduleTime, date *string
+	if pricingRule.Time != nil {
+		value := fmt.Sprintf("%s - %s", pricingRule.Time.StartTime, pricingRule.Time.EndTime)
+		scheduleTime = &value
+	}
+	if pricingRule.Date != nil {
+		value := pricingRule.Date.Format(time.DateOnly)
+		date = &value
+	}
+
+	return dto.PricingRuleResponse{
+		UUID:             pricingRule.UUID,
+		FieldName:        pricingRule.Field.Name,
+		Name:             pricingRule.Name,
+		Type:             pricingRule.Type,
+		Time:             scheduleTime,
+		Date:             date,
+		Price:            pricingRule.Price,
+		SurchargePercent: pricingRule.SurchargePercent,
+		CreatedAt:        pricingRule.CreatedAt,
+		UpdatedAt:        pricingRule.UpdatedAt,
+	}
+}
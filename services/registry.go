@@ -3,9 +3,14 @@ package services
 import (
 	"field-service/common/gcs"
 	"field-service/repositories"
+	auditLogService "field-service/services/auditlog"
+	closureService "field-service/services/closure"
 	fieldService "field-service/services/field"
 	fieldScheduleService "field-service/services/fieldschedule"
+	pricingRuleService "field-service/services/pricingrule"
+	scheduleTemplateService "field-service/services/scheduletemplate"
 	timeService "field-service/services/time"
+	venueService "field-service/services/venue"
 )
 
 type Registry struct {
@@ -17,6 +22,11 @@ type IServiceRegistry interface {
 	GetField() fieldService.IFieldService
 	GetFieldSchedule() fieldScheduleService.IFieldScheduleService
 	GetTime() timeService.ITimeService
+	GetScheduleTemplate() scheduleTemplateService.IScheduleTemplateService
+	GetPricingRule() pricingRuleService.IPricingRuleService
+	GetClosure() closureService.IClosureService
+	GetVenue() venueService.IVenueService
+	GetAuditLog() auditLogService.IAuditLogService
 }
 
 func NewServiceRegistry(repository repositories.IRepositoryRegistry, gcs gcs.IGCSClient) IServiceRegistry {
@@ -37,3 +47,23 @@ func (r *Registry) GetFieldSchedule() fieldScheduleService.IFieldScheduleService
 func (r *Registry) GetTime() timeService.ITimeService {
 	return timeService.NewTimeService(r.repository)
 }
+
+func (r *Registry) GetScheduleTemplate() scheduleTemplateService.IScheduleTemplateService {
+	return scheduleTemplateService.NewScheduleTemplateService(r.repository)
+}
+
+func (r *Registry) GetPricingRule() pricingRuleService.IPricingRuleService {
+	return pricingRuleService.NewPricingRuleService(r.repository)
+}
+
+func (r *Registry) GetClosure() closureService.IClosureService {
+	return closureService.NewClosureService(r.repository)
+}
+
+func (r *Registry) GetVenue() venueService.IVenueService {
+	return venueService.NewVenueService(r.repository)
+}
+
+func (r *Registry) GetAuditLog() auditLogService.IAuditLogService {
+	return auditLogService.NewAuditLogService(r.repository)
+}
@@ -0,0 +1,249 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errFieldSchedule "field-service/constants/error/fieldschedule"
+	errScheduleTemplate "field-service/constants/error/scheduletemplate"
+	errTime "field-service/constants/error/time"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	fieldScheduleService "field-service/services/fieldschedule"
+	venueService "field-service/services/venue"
+)
+
+type ScheduleTemplateService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IScheduleTemplateService interface {
+	GetAllByFieldUUID(context.Context, string) ([]dto.ScheduleTemplateResponse, error)
+	GetByUUID(context.Context, string, string) (*dto.ScheduleTemplateResponse, error)
+	Create(context.Context, string, *dto.ScheduleTemplateRequest) (*dto.ScheduleTemplateResponse, error)
+	Update(context.Context, string, string, *dto.ScheduleTemplateRequest) (*dto.ScheduleTemplateResponse, error)
+	Delete(context.Context, string, string) error
+	Materialize(context.Context, string) (*dto.GenerateFieldScheduleResponse, error)
+}
+
+func NewScheduleTemplateService(repository repositories.IRepositoryRegistry) IScheduleTemplateService {
+	return &ScheduleTemplateService{repository: repository}
+}
+
+// GetAllByFieldUUID implements IScheduleTemplateService.
+func (s *ScheduleTemplateService) GetAllByFieldUUID(ctx context.Context, fieldUUID string) ([]dto.ScheduleTemplateResponse, error) {
+	field, err := s.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTemplates, err := s.repository.GetScheduleTemplate().FindAllByFieldID(ctx, field.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.ScheduleTemplateResponse, 0, len(scheduleTemplates))
+	for _, scheduleTemplate := range scheduleTemplates {
+		results = append(results, s.toResponse(&scheduleTemplate))
+	}
+	return results, nil
+}
+
+// GetByUUID implements IScheduleTemplateService.
+func (s *ScheduleTemplateService) GetByUUID(
+	ctx context.Context,
+	fieldUUID string,
+	uuid string,
+) (*dto.ScheduleTemplateResponse, error) {
+	scheduleTemplate, err := s.findByFieldAndUUID(ctx, fieldUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toResponse(scheduleTemplate)
+	return &response, nil
+}
+
+// Create implements IScheduleTemplateService.
+func (s *ScheduleTemplateService) Create(
+	ctx context.Context,
+	fieldUUID string,
+	request *dto.ScheduleTemplateRequest,
+) (*dto.ScheduleTemplateResponse, error) {
+	field, err := s.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	times, err := s.resolveTemplate(ctx, field.ID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	isActive := true
+	if request.IsActive != nil {
+		isActive = *request.IsActive
+	}
+
+	scheduleTemplate, err := s.repository.GetScheduleTemplate().Create(ctx, &models.ScheduleTemplate{
+		FieldID:  field.ID,
+		Name:     request.Name,
+		Weekdays: request.Weekdays,
+		IsActive: isActive,
+		Times:    times,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTemplate.Field = *field
+	response := s.toResponse(scheduleTemplate)
+	return &response, nil
+}
+
+// Update implements IScheduleTemplateService.
+func (s *ScheduleTemplateService) Update(
+	ctx context.Context,
+	fieldUUID string,
+	uuid string,
+	request *dto.ScheduleTemplateRequest,
+) (*dto.ScheduleTemplateResponse, error) {
+	current, err := s.findByFieldAndUUID(ctx, fieldUUID, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	times, err := s.resolveTemplate(ctx, current.FieldID, request)
+	if err != nil {
+		return nil, err
+	}
+
+	isActive := current.IsActive
+	if request.IsActive != nil {
+		isActive = *request.IsActive
+	}
+
+	scheduleTemplate, err := s.repository.GetScheduleTemplate().Update(ctx, uuid, &models.ScheduleTemplate{
+		Name:     request.Name,
+		Weekdays: request.Weekdays,
+		IsActive: isActive,
+		Times:    times,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := s.toResponse(scheduleTemplate)
+	return &response, nil
+}
+
+// Delete implements IScheduleTemplateService.
+func (s *ScheduleTemplateService) Delete(ctx context.Context, fieldUUID string, uuid string) error {
+	_, err := s.findByFieldAndUUID(ctx, fieldUUID, uuid)
+	if err != nil {
+		return err
+	}
+
+	return s.repository.GetScheduleTemplate().Delete(ctx, uuid)
+}
+
+// Materialize implements IScheduleTemplateService.
+func (s *ScheduleTemplateService) Materialize(ctx context.Context, fieldUUID string) (*dto.GenerateFieldScheduleResponse, error) {
+	_, err := s.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return fieldScheduleService.NewFieldScheduleService(s.repository).GenerateFromTemplates(ctx, fieldUUID)
+}
+
+// findField loads the field in the path and checks the user may manage it.
+func (s *ScheduleTemplateService) findField(ctx context.Context, fieldUUID string) (*models.Field, error) {
+	field, err := s.repository.GetField().FindByUUID(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = venueService.AuthorizeField(ctx, field)
+	if err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+// findByFieldAndUUID makes sure the template belongs to the field in the path.
+func (s *ScheduleTemplateService) findByFieldAndUUID(
+	ctx context.Context,
+	fieldUUID string,
+	uuid string,
+) (*models.ScheduleTemplate, error) {
+	_, err := s.findField(ctx, fieldUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTemplate, err := s.repository.GetScheduleTemplate().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduleTemplate.Field.UUID.String() != fieldUUID {
+		return nil, errScheduleTemplate.ErrScheduleTemplateNotFound
+	}
+	return scheduleTemplate, nil
+}
+
+// resolveTemplate validates the weekdays and loads the referenced time slots, which must be active and either
+// global presets or slots derived for the template's field.
+func (s *ScheduleTemplateService) resolveTemplate(
+	ctx context.Context,
+	fieldID uint,
+	request *dto.ScheduleTemplateRequest,
+) ([]models.Time, error) {
+	for _, weekday := range request.Weekdays {
+		if _, ok := constants.GetWeekday(weekday); !ok {
+			return nil, errFieldSchedule.ErrInvalidWeekday
+		}
+	}
+
+	times := make([]models.Time, 0, len(request.TimeIDs))
+	for _, timeID := range request.TimeIDs {
+		scheduleTime, err := s.repository.GetTime().FindByUUID(ctx, timeID)
+		if err != nil {
+			return nil, err
+		}
+		if scheduleTime.FieldID != nil && *scheduleTime.FieldID != fieldID {
+			return nil, errTime.ErrTimeNotFound
+		}
+		if !scheduleTime.IsActive {
+			return nil, errTime.ErrTimeInactive
+		}
+		times = append(times, *scheduleTime)
+	}
+	return times, nil
+}
+
+func (s *ScheduleTemplateService) toResponse(scheduleTemplate *models.ScheduleTemplate) dto.ScheduleTemplateResponse {
+	times := make([]dto.TimeResponse, 0, len(scheduleTemplate.Times))
+	for _, item := range scheduleTemplate.Times {
+		times = append(times, dto.TimeResponse{
+			UUID:      item.UUID,
+			StartTime: item.StartTime,
+			EndTime:   item.EndTime,
+			IsActive:  item.IsActive,
+			CreatedAt: item.CreatedAt,
+			UpdatedAt: item.UpdatedAt,
+		})
+	}
+
+	return dto.ScheduleTemplateResponse{
+		UUID:      scheduleTemplate.UUID,
+		FieldName: scheduleTemplate.Field.Name,
+		Name:      scheduleTemplate.Name,
+		Weekdays:  scheduleTemplate.Weekdays,
+		IsActive:  scheduleTemplate.IsActive,
+		Times:     times,
+		CreatedAt: scheduleTemplate.CreatedAt,
+		UpdatedAt: scheduleTemplate.UpdatedAt,
+	}
+}
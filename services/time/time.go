@@ -2,9 +2,16 @@ package services
 
 import (
 	"context"
+	"field-service/common/util"
+	"field-service/constants"
+	errTime "field-service/constants/error/time"
 	"field-service/domain/dto"
 	"field-service/domain/models"
 	"field-service/repositories"
+	auditService "field-service/services/auditlog"
+	venueService "field-service/services/venue"
+
+	"gorm.io/gorm"
 )
 
 type TimeService struct {
@@ -15,6 +22,8 @@ type ITimeService interface {
 	GetAll(context.Context) ([]dto.TimeResponse, error)
 	GetByUUID(context.Context, string) (*dto.TimeResponse, error)
 	Create(context.Context, *dto.TimeRequest) (*dto.TimeResponse, error)
+	Update(context.Context, string, *dto.UpdateTimeRequest) (*dto.TimeResponse, error)
+	Delete(context.Context, string) error
 }
 
 func NewTimeService(repository repositories.IRepositoryRegistry) ITimeService {
@@ -33,11 +42,13 @@ func (t *TimeService) GetAll(ctx context.Context) ([]dto.TimeResponse, error) {
 	timeResults := make([]dto.TimeResponse, 0, len(times))
 	for _, time := range times {
 		timeResults = append(timeResults, dto.TimeResponse{
-			UUID:      time.UUID,
-			StartTime: time.StartTime,
-			EndTime:   time.EndTime,
-			CreatedAt: time.CreatedAt,
-			UpdatedAt: time.UpdatedAt,
+			UUID:            time.UUID,
+			StartTime:       time.StartTime,
+			EndTime:         time.EndTime,
+			CrossesMidnight: time.CrossesMidnight,
+			IsActive:        time.IsActive,
+			CreatedAt:       time.CreatedAt,
+			UpdatedAt:       time.UpdatedAt,
 		})
 	}
 
@@ -52,11 +63,13 @@ func (t *TimeService) GetByUUID(ctx context.Context, uuid string) (*dto.TimeResp
 	}
 
 	timeResult := dto.TimeResponse{
-		UUID:      time.UUID,
-		StartTime: time.StartTime,
-		EndTime:   time.EndTime,
-		CreatedAt: time.CreatedAt,
-		UpdatedAt: time.UpdatedAt,
+		UUID:            time.UUID,
+		StartTime:       time.StartTime,
+		EndTime:         time.EndTime,
+		CrossesMidnight: time.CrossesMidnight,
+		IsActive:        time.IsActive,
+		CreatedAt:       time.CreatedAt,
+		UpdatedAt:       time.UpdatedAt,
 	}
 
 	return &timeResult, nil
@@ -64,26 +77,275 @@ func (t *TimeService) GetByUUID(ctx context.Context, uuid string) (*dto.TimeResp
 
 // Create implements ITimeService.
 func (t *TimeService) Create(ctx context.Context, req *dto.TimeRequest) (*dto.TimeResponse, error) {
-	time := &dto.TimeRequest{
-		StartTime: req.StartTime,
-		EndTime:   req.EndTime,
+	time, err := t.validate(ctx, req, "")
+	if err != nil {
+		return nil, err
 	}
 
-	timeResult, err := t.repository.GetTime().Create(ctx, &models.Time{
-		StartTime: time.StartTime,
-		EndTime:   time.EndTime,
+	var timeResult *models.Time
+	err = t.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		var txErr error
+		timeResult, txErr = t.repository.GetTime().Create(ctx, tx, time)
+		if txErr != nil {
+			return txErr
+		}
+
+		return auditService.Record(ctx, t.repository, tx, auditService.Entry{
+			EntityType: constants.AuditTime,
+			EntityUUID: timeResult.UUID,
+			Action:     constants.AuditCreate,
+			After:      timeResult,
+		})
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	response := dto.TimeResponse{
-		UUID:      timeResult.UUID,
-		StartTime: timeResult.StartTime,
-		EndTime:   timeResult.EndTime,
-		CreatedAt: timeResult.CreatedAt,
-		UpdatedAt: timeResult.UpdatedAt,
+		UUID:            timeResult.UUID,
+		StartTime:       timeResult.StartTime,
+		EndTime:         timeResult.EndTime,
+		CrossesMidnight: timeResult.CrossesMidnight,
+		IsActive:        timeResult.IsActive,
+		CreatedAt:       timeResult.CreatedAt,
+		UpdatedAt:       timeResult.UpdatedAt,
 	}
 
 	return &response, nil
 }
+
+// Update implements ITimeService. Changing the range is refused while held or booked schedules from today on use
+// the time, since those slots were sold for the old range; unsold slots simply follow the new range. Deactivating
+// a time stops it from being generated and removes its unsold slots from today on.
+func (t *TimeService) Update(ctx context.Context, uuid string, req *dto.UpdateTimeRequest) (*dto.TimeResponse, error) {
+	current, err := t.repository.GetTime().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	if current.FieldID != nil {
+		return nil, errTime.ErrTimeOwnedByField
+	}
+
+	scheduleTime, err := t.validate(ctx, &dto.TimeRequest{
+		StartTime:       req.StartTime,
+		EndTime:         req.EndTime,
+		CrossesMidnight: req.CrossesMidnight,
+	}, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleTime.IsActive = current.IsActive
+	if req.IsActive != nil {
+		scheduleTime.IsActive = *req.IsActive
+	}
+
+	isRangeChanged := scheduleTime.StartTime != current.StartTime ||
+		scheduleTime.EndTime != current.EndTime ||
+		scheduleTime.CrossesMidnight != current.CrossesMidnight
+
+	var timeResult *models.Time
+	err = t.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		if isRangeChanged {
+			txErr := t.ensureNotInUse(ctx, tx, current.ID)
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		if current.IsActive && !scheduleTime.IsActive {
+			_, txErr := t.repository.GetFieldSchedule().DeleteUnbookedByTimeID(
+				ctx, tx, current.ID, venueService.DefaultLocation())
+			if txErr != nil {
+				return txErr
+			}
+		}
+
+		var txErr error
+		timeResult, txErr = t.repository.GetTime().Update(ctx, tx, uuid, scheduleTime)
+		if txErr != nil {
+			return txErr
+		}
+
+		return auditService.Record(ctx, t.repository, tx, auditService.Entry{
+			EntityType: constants.AuditTime,
+			EntityUUID: current.UUID,
+			Action:     constants.AuditUpdate,
+			Before:     current,
+			After:      timeResult,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := dto.TimeResponse{
+		UUID:            timeResult.UUID,
+		StartTime:       timeResult.StartTime,
+		EndTime:         timeResult.EndTime,
+		CrossesMidnight: timeResult.CrossesMidnight,
+		IsActive:        timeResult.IsActive,
+		CreatedAt:       timeResult.CreatedAt,
+		UpdatedAt:       timeResult.UpdatedAt,
+	}
+
+	return &response, nil
+}
+
+// Delete implements ITimeService. A time held or booked from today on cannot be deleted. A time that was only
+// sold in the past is deactivated instead, so the booking history keeps it; otherwise its schedules are removed
+// with it.
+func (t *TimeService) Delete(ctx context.Context, uuid string) error {
+	scheduleTime, err := t.repository.GetTime().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	if scheduleTime.FieldID != nil {
+		return errTime.ErrTimeOwnedByField
+	}
+
+	return t.repository.GetTx().Transaction(func(tx *gorm.DB) error {
+		txErr := t.ensureNotInUse(ctx, tx, scheduleTime.ID)
+		if txErr != nil {
+			return txErr
+		}
+
+		sold, txErr := t.repository.GetFieldSchedule().CountTakenByTimeID(ctx, tx, scheduleTime.ID, nil)
+		if txErr != nil {
+			return txErr
+		}
+		if sold > 0 {
+			return t.deactivate(ctx, tx, scheduleTime)
+		}
+
+		_, txErr = t.repository.GetFieldSchedule().DeleteUnbookedByTimeID(ctx, tx, scheduleTime.ID, nil)
+		if txErr != nil {
+			return txErr
+		}
+
+		txErr = t.repository.GetTime().Delete(ctx, tx, uuid)
+		if txErr != nil {
+			return txErr
+		}
+
+		return auditService.Record(ctx, t.repository, tx, auditService.Entry{
+			EntityType: constants.AuditTime,
+			EntityUUID: scheduleTime.UUID,
+			Action:     constants.AuditDelete,
+			Before:     scheduleTime,
+		})
+	})
+}
+
+// deactivate keeps a time that is part of the booking history and removes its unsold slots from today on.
+func (t *TimeService) deactivate(ctx context.Context, tx *gorm.DB, scheduleTime *models.Time) error {
+	_, err := t.repository.GetFieldSchedule().DeleteUnbookedByTimeID(
+		ctx, tx, scheduleTime.ID, venueService.DefaultLocation())
+	if err != nil {
+		return err
+	}
+
+	deactivated := *scheduleTime
+	deactivated.IsActive = false
+	timeResult, err := t.repository.GetTime().Update(ctx, tx, scheduleTime.UUID.String(), &deactivated)
+	if err != nil {
+		return err
+	}
+
+	return auditService.Record(ctx, t.repository, tx, auditService.Entry{
+		EntityType: constants.AuditTime,
+		EntityUUID: scheduleTime.UUID,
+		Action:     constants.AuditUpdate,
+		Before:     scheduleTime,
+		After:      timeResult,
+	})
+}
+
+// ensureNotInUse refuses a time with held or booked schedules from today on in their field's timezone; past
+// sales do not lock it.
+func (t *TimeService) ensureNotInUse(ctx context.Context, tx *gorm.DB, timeID uint) error {
+	total, err := t.repository.GetFieldSchedule().CountTakenByTimeID(ctx, tx, timeID, venueService.DefaultLocation())
+	if err != nil {
+		return err
+	}
+
+	if total > 0 {
+		return errTime.ErrTimeInUse
+	}
+	return nil
+}
+
+// validate normalizes the request to HH:MM:SS and rejects ranges that are inverted, duplicated or that
+// overlap another active time. The time identified by excludeUUID is ignored so it can be updated in place.
+func (t *TimeService) validate(ctx context.Context, req *dto.TimeRequest, excludeUUID string) (*models.Time, error) {
+	startTime, startMinute, err := util.ParseClock(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	endTime, endMinute, err := util.ParseClock(req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.CrossesMidnight != (endMinute <= startMinute) || startMinute == endMinute {
+		return nil, errTime.ErrInvalidTimeRange
+	}
+
+	times, err := t.repository.GetTime().FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ranges := clockRanges(startMinute, endMinute, req.CrossesMidnight)
+	for _, item := range times {
+		if item.UUID.String() == excludeUUID || !item.IsActive {
+			continue
+		}
+
+		_, itemStart, err := util.ParseClock(item.StartTime)
+		if err != nil {
+			continue
+		}
+		_, itemEnd, err := util.ParseClock(item.EndTime)
+		if err != nil {
+			continue
+		}
+
+		if itemStart == startMinute && itemEnd == endMinute {
+			return nil, errTime.ErrTimeIsExist
+		}
+
+		if overlaps(ranges, clockRanges(itemStart, itemEnd, item.CrossesMidnight)) {
+			return nil, errTime.ErrTimeOverlap
+		}
+	}
+
+	return &models.Time{
+		StartTime:       startTime,
+		EndTime:         endTime,
+		CrossesMidnight: req.CrossesMidnight,
+		IsActive:        true,
+	}, nil
+}
+
+// clockRanges splits a slot into half-open minute ranges within a single day.
+func clockRanges(startMinute, endMinute int, crossesMidnight bool) [][2]int {
+	if crossesMidnight {
+		return [][2]int{{startMinute, util.MinutesPerDay}, {0, endMinute}}
+	}
+	return [][2]int{{startMinute, endMinute}}
+}
+
+func overlaps(a, b [][2]int) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if x[0] < y[1] && y[0] < x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,38 @@
+package services
+
+import (
+	"context"
+	clients "field-service/clients/user"
+	"field-service/constants"
+	errConstant "field-service/constants/error"
+	"field-service/domain/models"
+)
+
+// CurrentUser returns the user resolved by middlewares.CheckRole, or nil when the request was authenticated
+// between services and carries no user.
+func CurrentUser(ctx context.Context) *clients.UserData {
+	user, ok := ctx.Value(constants.User).(*clients.UserData)
+	if !ok {
+		return nil
+	}
+	return user
+}
+
+// AuthorizeVenue lets admins manage every venue and venue owners only the venues they own.
+func AuthorizeVenue(ctx context.Context, venue *models.Venue) error {
+	user := CurrentUser(ctx)
+	if user == nil || user.Role != constants.VenueOwner {
+		return nil
+	}
+
+	if venue == nil || venue.OwnerUUID != user.UUID {
+		return errConstant.ErrForbidden
+	}
+	return nil
+}
+
+// AuthorizeField lets venue owners manage only the fields of their own venues. The field must be loaded with
+// its venue.
+func AuthorizeField(ctx context.Context, field *models.Field) error {
+	return AuthorizeVenue(ctx, field.Venue)
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"field-service/config"
+	"field-service/constants"
+	"field-service/domain/models"
+	"sync"
+	"time"
+)
+
+var locations sync.Map
+
+// DefaultLocation returns the configured fallback timezone, used for fields without a venue or timezone of
+// their own and for jobs that span every venue.
+func DefaultLocation() *time.Location {
+	if loc := loadLocation(config.Config.Timezone); loc != nil {
+		return loc
+	}
+	if loc := loadLocation(constants.DefaultTimezone); loc != nil {
+		return loc
+	}
+	return time.UTC
+}
+
+// FieldLocation returns the field's own timezone, then its venue's, then the default. The field must be loaded
+// with its venue.
+func FieldLocation(field *models.Field) *time.Location {
+	if field.Timezone != nil {
+		if loc := loadLocation(*field.Timezone); loc != nil {
+			return loc
+		}
+	}
+	if field.Venue != nil {
+		if loc := loadLocation(field.Venue.Timezone); loc != nil {
+			return loc
+		}
+	}
+	return DefaultLocation()
+}
+
+// Today returns midnight of the current day in loc.
+func Today(loc *time.Location) time.Time {
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+}
+
+// InLocation renders a timestamp in loc so it is emitted with that timezone's offset.
+func InLocation(t *time.Time, loc *time.Location) *time.Time {
+	if t == nil {
+		return nil
+	}
+	value := t.In(loc)
+	return &value
+}
+
+func loadLocation(name string) *time.Location {
+	if name == "" {
+		return nil
+	}
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location)
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil
+	}
+	locations.Store(name, loc)
+	return loc
+}
@@ -0,0 +1,172 @@
+package services
+
+import (
+	"context"
+	"field-service/constants"
+	errVenue "field-service/constants/error/venue"
+	"field-service/domain/dto"
+	"field-service/domain/models"
+	"field-service/repositories"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type VenueService struct {
+	repository repositories.IRepositoryRegistry
+}
+
+type IVenueService interface {
+	GetAll(context.Context) ([]dto.VenueResponse, error)
+	GetByUUID(context.Context, string) (*dto.VenueResponse, error)
+	Create(context.Context, *dto.VenueRequest) (*dto.VenueResponse, error)
+	Update(context.Context, string, *dto.VenueRequest) (*dto.VenueResponse, error)
+	Delete(context.Context, string) error
+}
+
+func NewVenueService(repository repositories.IRepositoryRegistry) IVenueService {
+	return &VenueService{repository: repository}
+}
+
+// GetAll implements IVenueService. Venue owners only see the venues they own.
+func (v *VenueService) GetAll(ctx context.Context) ([]dto.VenueResponse, error) {
+	var (
+		venues []models.Venue
+		err    error
+	)
+	user := CurrentUser(ctx)
+	if user != nil && user.Role == constants.VenueOwner {
+		venues, err = v.repository.GetVenue().FindAllByOwnerUUID(ctx, user.UUID)
+	} else {
+		venues, err = v.repository.GetVenue().FindAll(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]dto.VenueResponse, 0, len(venues))
+	for _, venue := range venues {
+		results = append(results, v.toResponse(&venue))
+	}
+	return results, nil
+}
+
+// GetByUUID implements IVenueService.
+func (v *VenueService) GetByUUID(ctx context.Context, uuid string) (*dto.VenueResponse, error) {
+	venue, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toResponse(venue)
+	return &response, nil
+}
+
+// Create implements IVenueService.
+func (v *VenueService) Create(ctx context.Context, request *dto.VenueRequest) (*dto.VenueResponse, error) {
+	req, err := v.resolveVenue(request)
+	if err != nil {
+		return nil, err
+	}
+
+	venue, err := v.repository.GetVenue().Create(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toResponse(venue)
+	return &response, nil
+}
+
+// Update implements IVenueService. Venue owners may edit their own venue but cannot hand it to someone else.
+func (v *VenueService) Update(ctx context.Context, uuid string, request *dto.VenueRequest) (*dto.VenueResponse, error) {
+	current, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	err = AuthorizeVenue(ctx, current)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := v.resolveVenue(request)
+	if err != nil {
+		return nil, err
+	}
+
+	user := CurrentUser(ctx)
+	if user != nil && user.Role == constants.VenueOwner {
+		req.OwnerUUID = current.OwnerUUID
+	}
+
+	venue, err := v.repository.GetVenue().Update(ctx, uuid, req)
+	if err != nil {
+		return nil, err
+	}
+
+	response := v.toResponse(venue)
+	return &response, nil
+}
+
+// Delete implements IVenueService.
+func (v *VenueService) Delete(ctx context.Context, uuid string) error {
+	_, err := v.repository.GetVenue().FindByUUID(ctx, uuid)
+	if err != nil {
+		return err
+	}
+
+	return v.repository.GetVenue().Delete(ctx, uuid)
+}
+
+// resolveVenue parses the owner and makes sure the timezone is a known IANA name, defaulting to Asia/Jakarta.
+func (v *VenueService) resolveVenue(request *dto.VenueRequest) (*models.Venue, error) {
+	ownerUUID, err := uuid.Parse(request.OwnerUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	timezone := request.Timezone
+	if timezone == "" {
+		timezone = constants.DefaultTimezone
+	}
+	if _, err = time.LoadLocation(timezone); err != nil {
+		return nil, errVenue.ErrInvalidTimezone
+	}
+
+	return &models.Venue{
+		OwnerUUID:   ownerUUID,
+		Name:        request.Name,
+		Address:     request.Address,
+		City:        request.City,
+		Latitude:    request.Latitude,
+		Longitude:   request.Longitude,
+		PhoneNumber: request.PhoneNumber,
+		Email:       request.Email,
+		Amenities:   request.Amenities,
+		Timezone:    timezone,
+	}, nil
+}
+
+func (v *VenueService) toResponse(venue *models.Venue) dto.VenueResponse {
+	amenities := []string(venue.Amenities)
+	if amenities == nil {
+		amenities = []string{}
+	}
+
+	return dto.VenueResponse{
+		UUID:        venue.UUID,
+		OwnerUUID:   venue.OwnerUUID,
+		Name:        venue.Name,
+		Address:     venue.Address,
+		City:        venue.City,
+		Latitude:    venue.Latitude,
+		Longitude:   venue.Longitude,
+		PhoneNumber: venue.PhoneNumber,
+		Email:       venue.Email,
+		Amenities:   amenities,
+		Timezone:    venue.Timezone,
+		CreatedAt:   venue.CreatedAt,
+		UpdatedAt:   venue.UpdatedAt,
+	}
+}